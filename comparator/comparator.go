@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/ilyaglow/go-qradar"
+	"qradar-content-compare/config"
 	"qradar-content-compare/qradarenhanced"
 	"qradar-content-compare/types"
 	"sort"
@@ -11,6 +12,13 @@ import (
 	"strings"
 )
 
+// configuration is set by SetConfiguration before the reports are generated.
+var configuration config.Config
+
+func SetConfiguration(newConfiguration config.Config) {
+	configuration = newConfiguration
+}
+
 func CompareTenants(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
 	oldContent, err := oldQRadar.Tenant.Get(context.Background(), "", "deleted=false", 0, 0)
 	if err != nil {
@@ -23,53 +31,58 @@ func CompareTenants(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.R
 	}
 
 	var sameCount = 0
-	var elementExists = false
 	var report = types.Report{}
 	report.ElementType = "Tenants"
 
-	for _, oldItem := range oldContent {
-		itemName := fmt.Sprintf("Name: %s", *oldItem.Name)
-		elementExists = false
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(qradar.Tenant).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
 
-		for _, newItem := range newContent {
-			if *oldItem.Name == *newItem.Name {
-				elementExists = true
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s", *oldItem.Name)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
 
-				var different = types.DifferentRecord{}
+		var different = types.DifferentRecord{}
 
-				if *oldItem.Description != *newItem.Description {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Description",
-						OldValue: *oldItem.Description,
-						NewValue: *newItem.Description,
-					})
-				}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
 
-				if *oldItem.EventRateLimit != *newItem.EventRateLimit {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Event Rate Limit",
-						OldValue: strconv.Itoa(*oldItem.EventRateLimit),
-						NewValue: strconv.Itoa(*newItem.EventRateLimit),
-					})
-				}
-				if *oldItem.FlowRateLimit != *newItem.FlowRateLimit {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Flow Rate Limit",
-						OldValue: strconv.Itoa(*oldItem.FlowRateLimit),
-						NewValue: strconv.Itoa(*newItem.FlowRateLimit),
-					})
-				}
-				if len(different.DifferentElements) > 0 {
-					different.RecordName = itemName
-					report.DifferentRecords = append(report.DifferentRecords, different)
-				} else {
-					sameCount++
-				}
-				break
-			}
+		if *oldItem.EventRateLimit != *newItem.EventRateLimit {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Event Rate Limit",
+				OldValue: strconv.Itoa(*oldItem.EventRateLimit),
+				NewValue: strconv.Itoa(*newItem.EventRateLimit),
+			})
 		}
-		if !elementExists {
-			report.MissingRecords = append(report.MissingRecords, itemName)
+		if *oldItem.FlowRateLimit != *newItem.FlowRateLimit {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Flow Rate Limit",
+				OldValue: strconv.Itoa(*oldItem.FlowRateLimit),
+				NewValue: strconv.Itoa(*newItem.FlowRateLimit),
+			})
+		}
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
 		}
 	}
 	report.SameCount = sameCount
@@ -90,52 +103,57 @@ func CompareDomains(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.R
 	}
 
 	var sameCount = 0
-	var elementExists = false
 	var report = types.Report{}
 	report.ElementType = "Domains"
 
-	for _, oldItem := range oldContent {
-		itemName := fmt.Sprintf("Name: %s (%s)", *oldItem.Name, *oldItem.Description)
-		elementExists = false
-
-		for _, newItem := range newContent {
-			if *oldItem.Name == *newItem.Name {
-				elementExists = true
-
-				var different = types.DifferentRecord{}
-				if *oldItem.Description != *newItem.Description {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Description",
-						OldValue: *oldItem.Description,
-						NewValue: *newItem.Description,
-					})
-				}
-				if oldItem.TenantName != newItem.TenantName {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Tenant Name",
-						OldValue: oldItem.TenantName,
-						NewValue: newItem.TenantName,
-					})
-				}
-				if !stringSliceEqual(oldItem.LogSourceGroupNames, newItem.LogSourceGroupNames) {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Log Source Group Names",
-						OldValue: strings.Join(oldItem.LogSourceGroupNames, ", "),
-						NewValue: strings.Join(newItem.LogSourceGroupNames, ", "),
-					})
-				}
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.DomainResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
 
-				if len(different.DifferentElements) > 0 {
-					different.RecordName = itemName
-					report.DifferentRecords = append(report.DifferentRecords, different)
-				} else {
-					sameCount++
-				}
-				break
-			}
-		}
-		if !elementExists {
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (%s)", *oldItem.Name, *oldItem.Description)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
 			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if oldItem.TenantName != newItem.TenantName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Tenant Name",
+				OldValue: oldItem.TenantName,
+				NewValue: newItem.TenantName,
+			})
+		}
+		if !stringSliceEqual(oldItem.LogSourceGroupNames, newItem.LogSourceGroupNames) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Log Source Group Names",
+				OldValue: strings.Join(oldItem.LogSourceGroupNames, ", "),
+				NewValue: strings.Join(newItem.LogSourceGroupNames, ", "),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
 		}
 	}
 	report.SameCount = sameCount
@@ -157,53 +175,57 @@ func CompareLogSourceGroups(oldQRadar *qradar.Client, newQRadar *qradar.Client)
 	}
 
 	var sameCount = 0
-	var elementExists = false
 	var report = types.Report{}
-	report.ElementType = "Log Source Goups"
+	report.ElementType = "Log Source Groups"
 
-	for _, oldItem := range oldContent {
-		itemName := fmt.Sprintf("Group Name: %s (Parent: %s)", *oldItem.Name, oldItem.ParentGroupName)
-		elementExists = false
-		for _, newItem := range newContent {
-			if *oldItem.Name == *newItem.Name && (oldItem.ParentGroupName == newItem.ParentGroupName || *oldItem.ParentID == 1) {
-				elementExists = true
-				var different = types.DifferentRecord{}
-
-				if *oldItem.Description != *newItem.Description {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Description",
-						OldValue: *oldItem.Description,
-						NewValue: *newItem.Description,
-					})
-				}
+	keyOf, err := configuredKey(report.ElementType, oldContent, logSourceGroupKey(newContent))
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
 
-				if oldItem.ParentGroupName != newItem.ParentGroupName {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Parent Group Name",
-						OldValue: oldItem.ParentGroupName,
-						NewValue: newItem.ParentGroupName,
-					})
-				}
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Group Name: %s (Parent: %s)", *oldItem.Name, oldItem.ParentGroupName)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+		var different = types.DifferentRecord{}
+
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
 
-				if !stringSliceEqual(oldItem.ChildGroupNames, newItem.ChildGroupNames) {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Child Group Names",
-						OldValue: strings.Join(oldItem.ChildGroupNames, ", "),
-						NewValue: strings.Join(newItem.ChildGroupNames, ", "),
-					})
-				}
+		if oldItem.ParentGroupName != newItem.ParentGroupName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Parent Group Name",
+				OldValue: oldItem.ParentGroupName,
+				NewValue: newItem.ParentGroupName,
+			})
+		}
 
-				if len(different.DifferentElements) > 0 {
-					different.RecordName = itemName
-					report.DifferentRecords = append(report.DifferentRecords, different)
-				} else {
-					sameCount++
-				}
-				break
-			}
+		if !stringSliceEqual(oldItem.ChildGroupNames, newItem.ChildGroupNames) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Child Group Names",
+				OldValue: strings.Join(oldItem.ChildGroupNames, ", "),
+				NewValue: strings.Join(newItem.ChildGroupNames, ", "),
+			})
 		}
-		if !elementExists {
-			report.MissingRecords = append(report.MissingRecords, itemName)
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
 		}
 	}
 	report.SameCount = sameCount
@@ -213,6 +235,37 @@ func CompareLogSourceGroups(oldQRadar *qradar.Client, newQRadar *qradar.Client)
 	return report, nil
 }
 
+// logSourceGroupKey matches groups by name and parent group. Groups directly below the root group match
+// independent of the name of the root group. An old group directly below the root group matches the first new
+// group of the same name under any parent, unless the new QRadar has that group below the root group as well.
+func logSourceGroupKey(newContent []types.LogSourceGroupsResolved) func(item interface{}) string {
+	isRootGroup := func(group types.LogSourceGroupsResolved) bool {
+		return group.ParentID != nil && *group.ParentID == 1
+	}
+	groupKey := func(group types.LogSourceGroupsResolved) string {
+		if isRootGroup(group) {
+			return matchKey(stringValue(group.Name), "")
+		}
+		return matchKey(stringValue(group.Name), group.ParentGroupName)
+	}
+
+	newKeys := make(map[string]string)
+	for _, group := range newContent {
+		name := stringValue(group.Name)
+		if _, ok := newKeys[name]; !ok || isRootGroup(group) {
+			newKeys[name] = groupKey(group)
+		}
+	}
+
+	return func(item interface{}) string {
+		group := item.(types.LogSourceGroupsResolved)
+		if key, ok := newKeys[stringValue(group.Name)]; ok && isRootGroup(group) {
+			return key
+		}
+		return groupKey(group)
+	}
+}
+
 func CompareLogSources(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
 	oldContent, err := qradarenhanced.GetLogSourcesResolved(oldQRadar)
 	if err != nil {
@@ -225,95 +278,101 @@ func CompareLogSources(oldQRadar *qradar.Client, newQRadar *qradar.Client) (type
 	}
 
 	var sameCount = 0
-	var elementExists = false
 	var report = types.Report{}
 	report.ElementType = "Log Sources"
 
-	for _, oldItem := range oldContent {
-		itemName := fmt.Sprintf("Name: %s", *oldItem.Name)
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.LogSourcesResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
 
-		elementExists = false
-		for _, newItem := range newContent {
-			if *oldItem.Name == *newItem.Name {
-				elementExists = true
-
-				var different = types.DifferentRecord{}
-				if *oldItem.Description != *newItem.Description {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Description",
-						OldValue: *oldItem.Description,
-						NewValue: *newItem.Description,
-					})
-				}
-				if oldItem.TypeName != newItem.TypeName {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Type Name",
-						OldValue: oldItem.TypeName,
-						NewValue: newItem.TypeName,
-					})
-				}
-				if oldItem.ExtensionName != newItem.ExtensionName {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Extension Name",
-						OldValue: oldItem.ExtensionName,
-						NewValue: newItem.ExtensionName,
-					})
-				}
-				if !stringSliceEqual(oldItem.LogSourceGroupNames, newItem.LogSourceGroupNames) {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Log Source Group Names",
-						OldValue: strings.Join(oldItem.LogSourceGroupNames, ", "),
-						NewValue: strings.Join(newItem.LogSourceGroupNames, ", "),
-					})
-				}
-				if *oldItem.Enabled != *newItem.Enabled {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Enabled",
-						OldValue: strconv.FormatBool(*oldItem.Enabled),
-						NewValue: strconv.FormatBool(*newItem.Enabled),
-					})
-				}
-				if *oldItem.Credibility != *newItem.Credibility {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Credibility",
-						OldValue: strconv.Itoa(*oldItem.Credibility),
-						NewValue: strconv.Itoa(*newItem.Credibility),
-					})
-				}
-				if *oldItem.StoreEventPayload != *newItem.StoreEventPayload {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Store Event Payload",
-						OldValue: strconv.FormatBool(*oldItem.StoreEventPayload),
-						NewValue: strconv.FormatBool(*newItem.StoreEventPayload),
-					})
-				}
-				if *oldItem.CoalesceEvents != *newItem.CoalesceEvents {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Coalesce Events",
-						OldValue: strconv.FormatBool(*oldItem.CoalesceEvents),
-						NewValue: strconv.FormatBool(*newItem.CoalesceEvents),
-					})
-				}
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s", *oldItem.Name)
 
-				if *oldItem.Status.Status != *newItem.Status.Status {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Status",
-						OldValue: *oldItem.Status.Status,
-						NewValue: *newItem.Status.Status,
-					})
-				}
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if oldItem.TypeName != newItem.TypeName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Type Name",
+				OldValue: oldItem.TypeName,
+				NewValue: newItem.TypeName,
+			})
+		}
+		if oldItem.ExtensionName != newItem.ExtensionName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Extension Name",
+				OldValue: oldItem.ExtensionName,
+				NewValue: newItem.ExtensionName,
+			})
+		}
+		if !stringSliceEqual(oldItem.LogSourceGroupNames, newItem.LogSourceGroupNames) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Log Source Group Names",
+				OldValue: strings.Join(oldItem.LogSourceGroupNames, ", "),
+				NewValue: strings.Join(newItem.LogSourceGroupNames, ", "),
+			})
+		}
+		if *oldItem.Enabled != *newItem.Enabled {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Enabled",
+				OldValue: strconv.FormatBool(*oldItem.Enabled),
+				NewValue: strconv.FormatBool(*newItem.Enabled),
+			})
+		}
+		if *oldItem.Credibility != *newItem.Credibility {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Credibility",
+				OldValue: strconv.Itoa(*oldItem.Credibility),
+				NewValue: strconv.Itoa(*newItem.Credibility),
+			})
+		}
+		if *oldItem.StoreEventPayload != *newItem.StoreEventPayload {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Store Event Payload",
+				OldValue: strconv.FormatBool(*oldItem.StoreEventPayload),
+				NewValue: strconv.FormatBool(*newItem.StoreEventPayload),
+			})
+		}
+		if *oldItem.CoalesceEvents != *newItem.CoalesceEvents {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Coalesce Events",
+				OldValue: strconv.FormatBool(*oldItem.CoalesceEvents),
+				NewValue: strconv.FormatBool(*newItem.CoalesceEvents),
+			})
+		}
 
-				if len(different.DifferentElements) > 0 {
-					different.RecordName = itemName
-					report.DifferentRecords = append(report.DifferentRecords, different)
-				} else {
-					sameCount++
-				}
-				break
-			}
+		if *oldItem.Status.Status != *newItem.Status.Status {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Status",
+				OldValue: *oldItem.Status.Status,
+				NewValue: *newItem.Status.Status,
+			})
 		}
-		if !elementExists {
-			report.MissingRecords = append(report.MissingRecords, itemName)
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
 		}
 	}
 	report.SameCount = sameCount
@@ -335,68 +394,74 @@ func CompareRules(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Rep
 	}
 
 	var sameCount = 0
-	var elementExists = false
 	var report = types.Report{}
 	report.ElementType = "Rules"
 
-	for _, oldItem := range oldContent {
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return item.(types.RulesWithDataResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
 		itemName := fmt.Sprintf("Rule Name: %s", oldItem.Name)
 
-		elementExists = false
-		for _, newItem := range newContent {
-			if oldItem.Name == newItem.Name {
-				elementExists = true
-
-				var different = types.DifferentRecord{}
-				if len(oldItem.TestDefinitions.Test) == len(newItem.TestDefinitions.Test) {
-					for _, testOld := range oldItem.TestDefinitions.Test {
-						if testOld.Name == "com.q1labs.semsources.cre.tests.RuleMatch_Test" {
-							for _, testNew := range newItem.TestDefinitions.Test {
-								if testNew.Name == "com.q1labs.semsources.cre.tests.RuleMatch_Test" && testOld.Uid == testNew.Uid {
-									if strconv.Itoa(len(strings.Split(testOld.Parameter[1].UserSelection, ", "))) != strconv.Itoa(len(strings.Split(testNew.Parameter[1].UserSelection, ", "))) {
-										different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-											Name:     "Has different Number Building Blocks",
-											OldValue: strconv.Itoa(len(strings.Split(testOld.Parameter[1].UserSelection, ", "))),
-											NewValue: strconv.Itoa(len(strings.Split(testNew.Parameter[1].UserSelection, ", "))),
-										})
-									}
-									if testOld.Parameter[1].UserSelection != testNew.Parameter[1].UserSelection {
-										different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-											Name:     "Has different Building Blocks Ids",
-											OldValue: testOld.Parameter[1].UserSelection,
-											NewValue: testNew.Parameter[1].UserSelection,
-										})
-									}
-									break
-								}
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if len(oldItem.TestDefinitions.Test) == len(newItem.TestDefinitions.Test) {
+			for _, testOld := range oldItem.TestDefinitions.Test {
+				if testOld.Name == "com.q1labs.semsources.cre.tests.RuleMatch_Test" {
+					for _, testNew := range newItem.TestDefinitions.Test {
+						if testNew.Name == "com.q1labs.semsources.cre.tests.RuleMatch_Test" && testOld.Uid == testNew.Uid {
+							if strconv.Itoa(len(strings.Split(testOld.Parameter[1].UserSelection, ", "))) != strconv.Itoa(len(strings.Split(testNew.Parameter[1].UserSelection, ", "))) {
+								different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+									Name:     "Has different Number Building Blocks",
+									OldValue: strconv.Itoa(len(strings.Split(testOld.Parameter[1].UserSelection, ", "))),
+									NewValue: strconv.Itoa(len(strings.Split(testNew.Parameter[1].UserSelection, ", "))),
+								})
 							}
+							if testOld.Parameter[1].UserSelection != testNew.Parameter[1].UserSelection {
+								different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+									Name:     "Has different Building Blocks Ids",
+									OldValue: testOld.Parameter[1].UserSelection,
+									NewValue: testNew.Parameter[1].UserSelection,
+								})
+							}
+							break
 						}
 					}
-				} else {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Has Different Number of Conditions",
-						OldValue: strconv.Itoa(len(oldItem.TestDefinitions.Test)),
-						NewValue: strconv.Itoa(len(newItem.TestDefinitions.Test)),
-					})
-				}
-				if *oldItem.RuleWithData.Enabled != *newItem.RuleWithData.Enabled {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Rule Enabled",
-						OldValue: strconv.FormatBool(*oldItem.RuleWithData.Enabled),
-						NewValue: strconv.FormatBool(*newItem.RuleWithData.Enabled),
-					})
 				}
-				if len(different.DifferentElements) > 0 {
-					different.RecordName = itemName
-					report.DifferentRecords = append(report.DifferentRecords, different)
-				} else {
-					sameCount++
-				}
-				break
 			}
+		} else {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Has Different Number of Conditions",
+				OldValue: strconv.Itoa(len(oldItem.TestDefinitions.Test)),
+				NewValue: strconv.Itoa(len(newItem.TestDefinitions.Test)),
+			})
 		}
-		if !elementExists {
-			report.MissingRecords = append(report.MissingRecords, itemName)
+		if *oldItem.RuleWithData.Enabled != *newItem.RuleWithData.Enabled {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Rule Enabled",
+				OldValue: strconv.FormatBool(*oldItem.RuleWithData.Enabled),
+				NewValue: strconv.FormatBool(*newItem.RuleWithData.Enabled),
+			})
+		}
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
 		}
 	}
 	report.SameCount = sameCount
@@ -407,12 +472,21 @@ func CompareRules(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Rep
 }
 
 func CompareDSMMappings(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
-	oldContent, err := qradarenhanced.GetDSMMappingsResolved(oldQRadar)
+	return compareDSMMappings(oldQRadar, newQRadar, false)
+}
+
+// CompareAllDSMMappings also compares the vendor shipped mappings, e.g. to find differences between DSM versions.
+func CompareAllDSMMappings(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	return compareDSMMappings(oldQRadar, newQRadar, true)
+}
+
+func compareDSMMappings(oldQRadar *qradar.Client, newQRadar *qradar.Client, allMappings bool) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetDSMMappingsResolved(oldQRadar, allMappings)
 	if err != nil {
 		return types.Report{}, err
 	}
 
-	newContent, err := qradarenhanced.GetDSMMappingsResolved(newQRadar)
+	newContent, err := qradarenhanced.GetDSMMappingsResolved(newQRadar, allMappings)
 	if err != nil {
 		return types.Report{}, err
 	}
@@ -420,22 +494,77 @@ func CompareDSMMappings(oldQRadar *qradar.Client, newQRadar *qradar.Client) (typ
 	var sameCount = 0
 	var report = types.Report{}
 	report.ElementType = "DSM Mappings"
+	if allMappings {
+		report.ElementType = "DSM Mappings (All)"
+	}
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return item.(types.DsmResolved).Key().String()
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
 
-	for searchString, oldItem := range oldContent {
-		if _, ok := newContent[searchString]; !ok {
+	for oldIndex, oldItem := range oldContent {
+		key := oldItem.Key()
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
 			var missingInformation = ""
-			missingInformation += fmt.Sprintf("Log Source Type: %s\n", oldItem.LogSourceTypeName)
-			missingInformation += fmt.Sprintf("Log Source Event ID: %s\n", *oldItem.LogSourceEventID)
-			missingInformation += fmt.Sprintf("Log Source Event Category: %s\n", *oldItem.LogSourceEventCategory)
+			missingInformation += fmt.Sprintf("Log Source Type: %s\n", key.LogSourceTypeName)
+			missingInformation += fmt.Sprintf("Log Source Event ID: %s\n", key.LogSourceEventID)
+			missingInformation += fmt.Sprintf("Log Source Event Category: %s\n", key.LogSourceEventCategory)
 			missingInformation += fmt.Sprintf("QID Name: %s\n", oldItem.QidName)
 			missingInformation += fmt.Sprintf("Is Custom Mapping: %s\n", strconv.FormatBool(*oldItem.CustomEvent))
 
 			report.MissingRecords = append(report.MissingRecords, missingInformation)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if oldItem.QidName != newItem.QidName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "QID Name",
+				OldValue: oldItem.QidName,
+				NewValue: newItem.QidName,
+			})
+		}
+		if oldItem.QidSeverity != newItem.QidSeverity {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Severity",
+				OldValue: strconv.Itoa(oldItem.QidSeverity),
+				NewValue: strconv.Itoa(newItem.QidSeverity),
+			})
+		}
+		if oldItem.LowLevelCategoryName != newItem.LowLevelCategoryName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Low Level Category",
+				OldValue: oldItem.LowLevelCategoryName,
+				NewValue: newItem.LowLevelCategoryName,
+			})
+		}
+		if *oldItem.CustomEvent != *newItem.CustomEvent {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Is Custom Mapping",
+				OldValue: strconv.FormatBool(*oldItem.CustomEvent),
+				NewValue: strconv.FormatBool(*newItem.CustomEvent),
+			})
+			if *oldItem.CustomEvent {
+				report.Summary = append(report.Summary, "changed from custom to default: "+key.String())
+			} else {
+				report.Summary = append(report.Summary, "changed from default to custom: "+key.String())
+			}
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = key.String()
+			report.DifferentRecords = append(report.DifferentRecords, different)
 		} else {
 			sameCount++
 		}
 	}
-
 	report.SameCount = sameCount
 	report.OldCount = len(oldContent)
 	report.NewCount = len(newContent)
@@ -443,90 +572,108 @@ func CompareDSMMappings(oldQRadar *qradar.Client, newQRadar *qradar.Client) (typ
 	return report, nil
 }
 
-func CompareQidMappings(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+// userCreatedQIDStart and userCreatedQIDEnd is the QID number range QRadar assigns to user created QIDs.
+const (
+	userCreatedQIDStart = 2000000
+	userCreatedQIDEnd   = 2999999
+)
+
+// qidMappingsMatchKeys is the report type the match key of both QID mapping reports is configured by,
+// as the QIDs are matched once before they are split into the user created and the system report.
+const qidMappingsMatchKeys = "QID Mappings"
+
+// CompareQidMappings matches QIDs by name, log source type and low level category and returns one report
+// for the user created QIDs and one for the system QIDs.
+func CompareQidMappings(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, types.Report, error) {
 	oldContent, err := qradarenhanced.GetQIDsResolved(oldQRadar)
 	if err != nil {
-		return types.Report{}, err
+		return types.Report{}, types.Report{}, err
 	}
 
 	newContent, err := qradarenhanced.GetQIDsResolved(newQRadar)
 	if err != nil {
-		return types.Report{}, err
+		return types.Report{}, types.Report{}, err
 	}
 
-	var sameCount = 0
-	var elementExists = false
-	var report = types.Report{}
-	report.ElementType = "QID Mappings"
+	var userCreatedReport = types.Report{}
+	userCreatedReport.ElementType = "QID Mappings (User Created)"
+	var systemReport = types.Report{}
+	systemReport.ElementType = "QID Mappings (System)"
 
-	for searchString, oldItem := range oldContent {
-		itemName := fmt.Sprintf("QID Name: %s (%s)", *oldItem.Name, strconv.Itoa(*oldItem.QID.QID))
-		if oldItem.LogSourceTypeName != "empty" {
-			itemName += " Log Source Type: " + oldItem.LogSourceTypeName
-		}
-		if oldItem.LowLevelCategoryName != "" {
-			itemName += "Low Level Category: " + oldItem.LowLevelCategoryName
+	qidReport := func(qid types.QIDsResolved) *types.Report {
+		if isUserCreatedQID(*qid.QID.QID) {
+			return &userCreatedReport
 		}
+		return &systemReport
+	}
 
-		elementExists = false
-
-		if newItem, ok := newContent[searchString]; ok {
-			elementExists = true
-
-			var different = types.DifferentRecord{}
-			if *oldItem.Name != *newItem.Name {
-				different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-					Name:     "QID Name",
-					OldValue: *oldItem.Name,
-					NewValue: *newItem.Name,
-				})
-			}
+	keyOf, err := configuredKey(qidMappingsMatchKeys, oldContent, func(item interface{}) string {
+		return item.(types.QIDsResolved).Key().String()
+	})
+	if err != nil {
+		return types.Report{}, types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	for _, duplicate := range matches.oldDuplicates {
+		report := qidReport(oldContent[duplicate.Indexes[0]])
+		report.AmbiguousMatches = append(report.AmbiguousMatches, duplicate.ambiguousMatch("old", oldContent))
+	}
+	for _, duplicate := range matches.newDuplicates {
+		report := qidReport(newContent[duplicate.Indexes[0]])
+		report.AmbiguousMatches = append(report.AmbiguousMatches, duplicate.ambiguousMatch("new", newContent))
+	}
 
-			if oldItem.LowLevelCategoryName != newItem.LowLevelCategoryName {
-				different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-					Name:     "Low Level Category Name",
-					OldValue: oldItem.LowLevelCategoryName,
-					NewValue: newItem.LowLevelCategoryName,
-				})
-			}
-			if oldItem.LogSourceTypeName != newItem.LogSourceTypeName {
-				different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-					Name:     "Log Source Type Name",
-					OldValue: oldItem.LogSourceTypeName,
-					NewValue: newItem.LogSourceTypeName,
-				})
-			}
-			if *oldItem.Severity != *newItem.Severity {
-				different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-					Name:     "Severity",
-					OldValue: strconv.Itoa(*oldItem.Severity),
-					NewValue: strconv.Itoa(*newItem.Severity),
-				})
-			}
-			if *oldItem.Description != *newItem.Description {
-				different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-					Name:     "Description",
-					OldValue: *oldItem.Description,
-					NewValue: *newItem.Description,
-				})
-			}
-			if len(different.DifferentElements) > 0 {
-				different.RecordName = itemName
-				report.DifferentRecords = append(report.DifferentRecords, different)
-			} else {
-				sameCount++
-			}
-		}
+	for oldIndex, oldItem := range oldContent {
+		report := qidReport(oldItem)
+		report.OldCount++
 
-		if !elementExists {
+		itemName := fmt.Sprintf("%s (%s)", oldItem.Key().String(), strconv.Itoa(*oldItem.QID.QID))
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
 			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.QID.QID != *newItem.QID.QID {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "QID Number",
+				OldValue: strconv.Itoa(*oldItem.QID.QID),
+				NewValue: strconv.Itoa(*newItem.QID.QID),
+			})
+		}
+		if *oldItem.Severity != *newItem.Severity {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Severity",
+				OldValue: strconv.Itoa(*oldItem.Severity),
+				NewValue: strconv.Itoa(*newItem.Severity),
+			})
+		}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			report.SameCount++
 		}
 	}
-	report.SameCount = sameCount
-	report.OldCount = len(oldContent)
-	report.NewCount = len(newContent)
 
-	return report, nil
+	for _, newItem := range newContent {
+		qidReport(newItem).NewCount++
+	}
+
+	return userCreatedReport, systemReport, nil
+}
+
+func isUserCreatedQID(qid int) bool {
+	return qid >= userCreatedQIDStart && qid <= userCreatedQIDEnd
 }
 
 func CompareNetworkHierarchy(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
@@ -541,46 +688,48 @@ func CompareNetworkHierarchy(oldQRadar *qradar.Client, newQRadar *qradar.Client)
 	}
 
 	var sameCount = 0
-	var elementExists = false
 	var report = types.Report{}
 	report.ElementType = "Network Hierarchy"
 
-	for _, oldItem := range oldContent {
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		record := item.(types.NetworkHierarchyResolved)
+		return matchKey(record.DomainName, *record.Name, *record.Cidr)
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+
+	for oldIndex, oldItem := range oldContent {
 		itemName := fmt.Sprintf("Name: %s\nCidr: %s\nGroup: %s\nDomain: %s\n", *oldItem.Name, *oldItem.Cidr, *oldItem.Group, oldItem.DomainName)
-		elementExists = false
-
-		for _, newItem := range newContent {
-			if oldItem.DomainName == newItem.DomainName &&
-				*oldItem.Name == *newItem.Name &&
-				*oldItem.Cidr == *newItem.Cidr {
-				elementExists = true
-
-				var different = types.DifferentRecord{}
-				if *oldItem.Description != *newItem.Description {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Description",
-						OldValue: *oldItem.Description,
-						NewValue: *newItem.Description,
-					})
-				}
-				if *oldItem.Group != *newItem.Group {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Group",
-						OldValue: *oldItem.Group,
-						NewValue: *newItem.Group,
-					})
-				}
-				if len(different.DifferentElements) > 0 {
-					different.RecordName = itemName
-					report.DifferentRecords = append(report.DifferentRecords, different)
-				} else {
-					sameCount++
-				}
-				break
-			}
-		}
-		if !elementExists {
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
 			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if *oldItem.Group != *newItem.Group {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Group",
+				OldValue: *oldItem.Group,
+				NewValue: *newItem.Group,
+			})
+		}
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
 		}
 	}
 	report.SameCount = sameCount
@@ -602,59 +751,65 @@ func CompareRuleGroups(oldQRadar *qradar.Client, newQRadar *qradar.Client) (type
 	}
 
 	var sameCount = 0
-	var elementExists = false
 	var report = types.Report{}
 	report.ElementType = "Rule Groups"
 
-	for _, oldItem := range oldContent {
-		itemName := fmt.Sprintf("Name: %s (Parent Name: %s)", *oldItem.Name, oldItem.ParentName)
-		elementExists = false
-		for _, newItem := range newContent {
-			if *oldItem.Name == *newItem.Name {
-				elementExists = true
-
-				var different = types.DifferentRecord{}
-				if *oldItem.Description != *newItem.Description {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Description",
-						OldValue: *oldItem.Description,
-						NewValue: *newItem.Description,
-					})
-				}
-				if oldItem.ParentName != newItem.ParentName {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Parent Name",
-						OldValue: oldItem.ParentName,
-						NewValue: newItem.ParentName,
-					})
-				}
-				if *oldItem.Type != *newItem.Type {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Type",
-						OldValue: *oldItem.Type,
-						NewValue: *newItem.Type,
-					})
-				}
-				missingInOld, missingInNew, isEquals := listCompare(oldItem.RuleNamesAssociated, newItem.RuleNamesAssociated)
-				if !isEquals {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Missing Associated Rules (missing in Old/New)",
-						OldValue: strings.Join(missingInOld, "\n"),
-						NewValue: strings.Join(missingInNew, "\n"),
-					})
-				}
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.RuleGroupResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
 
-				if len(different.DifferentElements) > 0 {
-					different.RecordName = itemName
-					report.DifferentRecords = append(report.DifferentRecords, different)
-				} else {
-					sameCount++
-				}
-				break
-			}
-		}
-		if !elementExists {
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Parent Name: %s)", *oldItem.Name, oldItem.ParentName)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
 			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if oldItem.ParentName != newItem.ParentName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Parent Name",
+				OldValue: oldItem.ParentName,
+				NewValue: newItem.ParentName,
+			})
+		}
+		if *oldItem.Type != *newItem.Type {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Type",
+				OldValue: *oldItem.Type,
+				NewValue: *newItem.Type,
+			})
+		}
+		missingInOld, missingInNew, isEquals := listCompare(oldItem.RuleNamesAssociated, newItem.RuleNamesAssociated)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Associated Rules (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
 		}
 	}
 	report.SameCount = sameCount
@@ -676,71 +831,75 @@ func CompareCustomProperties(oldQRadar *qradar.Client, newQRadar *qradar.Client)
 	}
 
 	var sameCount = 0
-	var elementExists = false
 	var report = types.Report{}
 	report.ElementType = "Custom Properties"
-	for _, oldItem := range oldContent {
-		itemName := fmt.Sprintf("Identifier: %s (Log Source Type: %s, Regex: %s)", *oldItem.Identifier, oldItem.LogSourceTypeName, *oldItem.Regex)
-		elementExists = false
-		for _, newItem := range newContent {
-			if *oldItem.Identifier == *newItem.Identifier {
-				elementExists = true
-
-				var different = types.DifferentRecord{}
-				if oldItem.LogSourceTypeName != newItem.LogSourceTypeName {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Log Source Type",
-						OldValue: oldItem.LogSourceTypeName,
-						NewValue: newItem.LogSourceTypeName,
-					})
-				}
-				if oldItem.LogSourceName != newItem.LogSourceName {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Log Source",
-						OldValue: oldItem.LogSourceName,
-						NewValue: newItem.LogSourceName,
-					})
-				}
-				if oldItem.LowLevelCategoryName != newItem.LowLevelCategoryName {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Low Level Category",
-						OldValue: oldItem.LowLevelCategoryName,
-						NewValue: newItem.LowLevelCategoryName,
-					})
-				}
-				if oldItem.QidName != newItem.QidName {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "QID",
-						OldValue: oldItem.QidName,
-						NewValue: newItem.QidName,
-					})
-				}
-				if *oldItem.Regex != *newItem.Regex {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Regex",
-						OldValue: *oldItem.Regex,
-						NewValue: *newItem.Regex,
-					})
-				}
-				if *oldItem.Enabled != *newItem.Enabled {
-					different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
-						Name:     "Enabled",
-						OldValue: strconv.FormatBool(*oldItem.Enabled),
-						NewValue: strconv.FormatBool(*newItem.Enabled),
-					})
-				}
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.PropertyExpressionRegexResolved).Identifier
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
 
-				if len(different.DifferentElements) > 0 {
-					different.RecordName = itemName
-					report.DifferentRecords = append(report.DifferentRecords, different)
-				} else {
-					sameCount++
-				}
-				break
-			}
-		}
-		if !elementExists {
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Identifier: %s (Log Source Type: %s, Regex: %s)", *oldItem.Identifier, oldItem.LogSourceTypeName, *oldItem.Regex)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
 			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if oldItem.LogSourceTypeName != newItem.LogSourceTypeName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Log Source Type",
+				OldValue: oldItem.LogSourceTypeName,
+				NewValue: newItem.LogSourceTypeName,
+			})
+		}
+		if oldItem.LogSourceName != newItem.LogSourceName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Log Source",
+				OldValue: oldItem.LogSourceName,
+				NewValue: newItem.LogSourceName,
+			})
+		}
+		if oldItem.LowLevelCategoryName != newItem.LowLevelCategoryName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Low Level Category",
+				OldValue: oldItem.LowLevelCategoryName,
+				NewValue: newItem.LowLevelCategoryName,
+			})
+		}
+		if oldItem.QidName != newItem.QidName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "QID",
+				OldValue: oldItem.QidName,
+				NewValue: newItem.QidName,
+			})
+		}
+		if *oldItem.Regex != *newItem.Regex {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Regex",
+				OldValue: *oldItem.Regex,
+				NewValue: *newItem.Regex,
+			})
+		}
+		if *oldItem.Enabled != *newItem.Enabled {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Enabled",
+				OldValue: strconv.FormatBool(*oldItem.Enabled),
+				NewValue: strconv.FormatBool(*newItem.Enabled),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
 		}
 	}
 	report.SameCount = sameCount
@@ -750,44 +909,1978 @@ func CompareCustomProperties(oldQRadar *qradar.Client, newQRadar *qradar.Client)
 	return report, nil
 }
 
-func listCompare(oldList, newList []string) ([]string, []string, bool) {
-	sort.Strings(oldList)
-	sort.Strings(newList)
+func CompareCustomPropertiesJSON(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	return compareCustomPropertyExpressions(oldQRadar, newQRadar, qradarenhanced.PropertyExpressionJSON)
+}
 
-	if strings.Join(oldList, "") == strings.Join(newList, "") {
-		return nil, nil, true
-	}
-	var missingInOld []string
-	var missingInNew []string
+func CompareCustomPropertiesLEEF(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	return compareCustomPropertyExpressions(oldQRadar, newQRadar, qradarenhanced.PropertyExpressionLEEF)
+}
 
-	for _, oldItem := range oldList {
-		exists := false
-		for _, newItem := range newList {
-			if oldItem == newItem {
-				exists = true
-				break
-			}
-		}
-		if !exists {
-			missingInNew = append(missingInNew, oldItem)
-		}
-	}
+func CompareCustomPropertiesCEF(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	return compareCustomPropertyExpressions(oldQRadar, newQRadar, qradarenhanced.PropertyExpressionCEF)
+}
+
+func CompareCustomPropertiesNVP(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	return compareCustomPropertyExpressions(oldQRadar, newQRadar, qradarenhanced.PropertyExpressionNVP)
+}
+
+func CompareCustomPropertiesXML(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	return compareCustomPropertyExpressions(oldQRadar, newQRadar, qradarenhanced.PropertyExpressionXML)
+}
+
+// compareCustomPropertyExpressions compares the non regex property expressions. Expressions are matched by the
+// property name and the resolved log source type, log source, low level category and qid they are restricted to.
+func compareCustomPropertyExpressions(oldQRadar *qradar.Client, newQRadar *qradar.Client, expressionType string) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetPropertyExpressionsResolved(oldQRadar, expressionType)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetPropertyExpressionsResolved(newQRadar, expressionType)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Custom Properties (" + expressionType + ")"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		record := item.(types.PropertyExpressionResolved)
+		// several expressions for the same log source are listed as ambiguous matches
+		return matchKey(record.PropertyName, record.LogSourceTypeName, record.LogSourceName, record.LowLevelCategoryName, record.QidName)
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Property: %s (Log Source Type: %s, Expression: %s)", oldItem.PropertyName, oldItem.LogSourceTypeName, stringValue(oldItem.Expression))
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if stringValue(oldItem.Expression) != stringValue(newItem.Expression) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Expression",
+				OldValue: stringValue(oldItem.Expression),
+				NewValue: stringValue(newItem.Expression),
+			})
+		}
+		if expressionType == qradarenhanced.PropertyExpressionNVP {
+			if stringValue(oldItem.DelimeterNameValue) != stringValue(newItem.DelimeterNameValue) {
+				different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+					Name:     "Name Value Delimiter",
+					OldValue: stringValue(oldItem.DelimeterNameValue),
+					NewValue: stringValue(newItem.DelimeterNameValue),
+				})
+			}
+			if stringValue(oldItem.DelimeterPair) != stringValue(newItem.DelimeterPair) {
+				different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+					Name:     "Pair Delimiter",
+					OldValue: stringValue(oldItem.DelimeterPair),
+					NewValue: stringValue(newItem.DelimeterPair),
+				})
+			}
+		}
+		if boolValue(oldItem.Enabled) != boolValue(newItem.Enabled) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Enabled",
+				OldValue: boolValue(oldItem.Enabled),
+				NewValue: boolValue(newItem.Enabled),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareRegexProperties(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetRegexPropertiesResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetRegexPropertiesResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Custom Property Definitions"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.RegexPropertyResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Type: %s)", *oldItem.Name, *oldItem.PropertyType)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.PropertyType != *newItem.PropertyType {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Data Type",
+				OldValue: *oldItem.PropertyType,
+				NewValue: *newItem.PropertyType,
+			})
+		}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if *oldItem.UseForRuleEngine != *newItem.UseForRuleEngine {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Use For Rule Correlation (Optimised Parsing)",
+				OldValue: strconv.FormatBool(*oldItem.UseForRuleEngine),
+				NewValue: strconv.FormatBool(*newItem.UseForRuleEngine),
+			})
+		}
+		// only set for DATE properties
+		if stringValue(oldItem.DatetimeFormat) != stringValue(newItem.DatetimeFormat) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Datetime Format",
+				OldValue: stringValue(oldItem.DatetimeFormat),
+				NewValue: stringValue(newItem.DatetimeFormat),
+			})
+		}
+		// only set for DATE properties
+		if stringValue(oldItem.Locale) != stringValue(newItem.Locale) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Locale",
+				OldValue: stringValue(oldItem.Locale),
+				NewValue: stringValue(newItem.Locale),
+			})
+		}
+
+		var oldExpressions []string
+		for _, expression := range oldItem.Expressions {
+			oldExpressions = append(oldExpressions, propertyExpressionRegexToString(expression))
+		}
+		var newExpressions []string
+		for _, expression := range newItem.Expressions {
+			newExpressions = append(newExpressions, propertyExpressionRegexToString(expression))
+		}
+		missingInOld, missingInNew, isEquals := listCompare(oldExpressions, newExpressions)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Expressions (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareCalculatedProperties(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetCalculatedPropertiesResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetCalculatedPropertiesResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Custom Properties (Calculated)"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.CalculatedPropertyResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Expression: %s)", *oldItem.Name, oldItem.Expression)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if oldItem.Expression != newItem.Expression {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Expression",
+				OldValue: oldItem.Expression,
+				NewValue: newItem.Expression,
+			})
+		}
+		if *oldItem.PropertyType != *newItem.PropertyType {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Data Type",
+				OldValue: *oldItem.PropertyType,
+				NewValue: *newItem.PropertyType,
+			})
+		}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if *oldItem.UseForRuleEngine != *newItem.UseForRuleEngine {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Use For Rule Correlation",
+				OldValue: strconv.FormatBool(*oldItem.UseForRuleEngine),
+				NewValue: strconv.FormatBool(*newItem.UseForRuleEngine),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareAQLProperties(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetAQLProperties(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetAQLProperties(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Custom Properties (AQL)"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.AQLProperty).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Expression: %s)", *oldItem.Name, *oldItem.Expression)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Expression != *newItem.Expression {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Expression",
+				OldValue: *oldItem.Expression,
+				NewValue: *newItem.Expression,
+			})
+		}
+		if *oldItem.PropertyType != *newItem.PropertyType {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Data Type",
+				OldValue: *oldItem.PropertyType,
+				NewValue: *newItem.PropertyType,
+			})
+		}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if *oldItem.UseForRuleEngine != *newItem.UseForRuleEngine {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Use For Rule Correlation",
+				OldValue: strconv.FormatBool(*oldItem.UseForRuleEngine),
+				NewValue: strconv.FormatBool(*newItem.UseForRuleEngine),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareAuthorizedServices(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetAuthorizedServicesResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetAuthorizedServicesResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Authorized Services"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.AuthorizedServiceResolved).Label
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Label: %s (Role: %s)", *oldItem.Label, oldItem.RoleName)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if oldItem.RoleName != newItem.RoleName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Role",
+				OldValue: oldItem.RoleName,
+				NewValue: newItem.RoleName,
+			})
+		}
+		if oldItem.SecurityProfileName != newItem.SecurityProfileName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Security Profile",
+				OldValue: oldItem.SecurityProfileName,
+				NewValue: newItem.SecurityProfileName,
+			})
+		}
+		if oldItem.Expiry != newItem.Expiry {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Expiry Date",
+				OldValue: oldItem.Expiry,
+				NewValue: newItem.Expiry,
+			})
+		}
+		if oldItem.TenantName != newItem.TenantName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Tenant Name",
+				OldValue: oldItem.TenantName,
+				NewValue: newItem.TenantName,
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareExtensions(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetExtensions(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetExtensions(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Extensions"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.Extension).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Version: %s)", stringValue(oldItem.Name), stringValue(oldItem.Version))
+		isInstalled := stringValue(oldItem.Status) == "INSTALLED"
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if stringValue(oldItem.Version) != stringValue(newItem.Version) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Version",
+				OldValue: stringValue(oldItem.Version),
+				NewValue: stringValue(newItem.Version),
+			})
+			if isInstalled && compareVersions(stringValue(oldItem.Version), stringValue(newItem.Version)) > 0 {
+				report.Summary = append(report.Summary, fmt.Sprintf("older in new QRadar: %s (%s < %s)", stringValue(oldItem.Name), stringValue(newItem.Version), stringValue(oldItem.Version)))
+			}
+		}
+		if stringValue(oldItem.Status) != stringValue(newItem.Status) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Status",
+				OldValue: stringValue(oldItem.Status),
+				NewValue: stringValue(newItem.Status),
+			})
+			if isInstalled {
+				report.Summary = append(report.Summary, fmt.Sprintf("not installed in new QRadar: %s (Status: %s)", stringValue(oldItem.Name), stringValue(newItem.Status)))
+			}
+		}
+		if stringValue(oldItem.Author) != stringValue(newItem.Author) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Author",
+				OldValue: stringValue(oldItem.Author),
+				NewValue: stringValue(newItem.Author),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareApplications(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetApplications(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetApplications(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Apps"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.Application).Manifest.Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Version: %s)", stringValue(oldItem.Manifest.Name), stringValue(oldItem.Manifest.Version))
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if stringValue(oldItem.Manifest.Version) != stringValue(newItem.Manifest.Version) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Version",
+				OldValue: stringValue(oldItem.Manifest.Version),
+				NewValue: stringValue(newItem.Manifest.Version),
+			})
+			if compareVersions(stringValue(oldItem.Manifest.Version), stringValue(newItem.Manifest.Version)) > 0 {
+				report.Summary = append(report.Summary, fmt.Sprintf("older in new QRadar: %s (%s < %s)", stringValue(oldItem.Manifest.Name), stringValue(newItem.Manifest.Version), stringValue(oldItem.Manifest.Version)))
+			}
+		}
+		if stringValue(oldItem.ApplicationState.Status) != stringValue(newItem.ApplicationState.Status) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Status",
+				OldValue: stringValue(oldItem.ApplicationState.Status),
+				NewValue: stringValue(newItem.ApplicationState.Status),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareForwardingDestinations(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetForwardingDestinations(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetForwardingDestinations(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Forwarding Destinations"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.ForwardingDestination).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (%s:%d)", *oldItem.Name, *oldItem.Host, *oldItem.Port)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Host != *newItem.Host {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Host",
+				OldValue: *oldItem.Host,
+				NewValue: *newItem.Host,
+			})
+		}
+		if *oldItem.Port != *newItem.Port {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Port",
+				OldValue: strconv.Itoa(*oldItem.Port),
+				NewValue: strconv.Itoa(*newItem.Port),
+			})
+		}
+		if *oldItem.Protocol != *newItem.Protocol {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Protocol",
+				OldValue: *oldItem.Protocol,
+				NewValue: *newItem.Protocol,
+			})
+		}
+		if *oldItem.EventFormat != *newItem.EventFormat {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Event Format",
+				OldValue: *oldItem.EventFormat,
+				NewValue: *newItem.EventFormat,
+			})
+		}
+		if *oldItem.Enabled != *newItem.Enabled {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Enabled",
+				OldValue: strconv.FormatBool(*oldItem.Enabled),
+				NewValue: strconv.FormatBool(*newItem.Enabled),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareRoutingRules(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetRoutingRulesResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetRoutingRulesResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Routing Rules"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.RoutingRuleResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Mode: %s)", *oldItem.Name, *oldItem.Mode)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Mode != *newItem.Mode {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Mode",
+				OldValue: *oldItem.Mode,
+				NewValue: *newItem.Mode,
+			})
+		}
+		if *oldItem.Enabled != *newItem.Enabled {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Enabled",
+				OldValue: strconv.FormatBool(*oldItem.Enabled),
+				NewValue: strconv.FormatBool(*newItem.Enabled),
+			})
+		}
+		missingInOld, missingInNew, isEquals := listCompare(oldItem.RoutingOptions, newItem.RoutingOptions)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Routing Options (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+		missingInOld, missingInNew, isEquals = listCompare(oldItem.FilterNames, newItem.FilterNames)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Filters (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+		missingInOld, missingInNew, isEquals = listCompare(oldItem.DestinationNames, newItem.DestinationNames)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Destinations (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareEventRetentionBuckets(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	return compareRetentionBuckets(oldQRadar, newQRadar, qradarenhanced.RetentionBucketsEvents)
+}
+
+func CompareFlowRetentionBuckets(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	return compareRetentionBuckets(oldQRadar, newQRadar, qradarenhanced.RetentionBucketsFlows)
+}
+
+// compareRetentionBuckets matches buckets by name within the same tenant and domain, as every tenant has its own buckets.
+func compareRetentionBuckets(oldQRadar *qradar.Client, newQRadar *qradar.Client, database string) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetRetentionBucketsResolved(oldQRadar, database)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetRetentionBucketsResolved(newQRadar, database)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	if database == qradarenhanced.RetentionBucketsEvents {
+		report.ElementType = "Event Retention Buckets"
+	} else {
+		report.ElementType = "Flow Retention Buckets"
+	}
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		record := item.(types.RetentionBucketResolved)
+		return matchKey(stringValue(record.Name), record.TenantName, record.DomainName)
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Tenant: %s, Domain: %s)", stringValue(oldItem.Name), oldItem.TenantName, oldItem.DomainName)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if stringValue(oldItem.Description) != stringValue(newItem.Description) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: stringValue(oldItem.Description),
+				NewValue: stringValue(newItem.Description),
+			})
+		}
+		if stringValue(oldItem.Filter) != stringValue(newItem.Filter) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Filter",
+				OldValue: stringValue(oldItem.Filter),
+				NewValue: stringValue(newItem.Filter),
+			})
+		}
+		if intValue(oldItem.Period) != intValue(newItem.Period) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Retention Period",
+				OldValue: intValue(oldItem.Period),
+				NewValue: intValue(newItem.Period),
+			})
+		}
+		if stringValue(oldItem.Compression) != stringValue(newItem.Compression) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Compression",
+				OldValue: stringValue(oldItem.Compression),
+				NewValue: stringValue(newItem.Compression),
+			})
+		}
+		if stringValue(oldItem.DeletionPolicy) != stringValue(newItem.DeletionPolicy) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Deletion Policy",
+				OldValue: stringValue(oldItem.DeletionPolicy),
+				NewValue: stringValue(newItem.DeletionPolicy),
+			})
+		}
+		if intValue(oldItem.Priority) != intValue(newItem.Priority) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Priority",
+				OldValue: intValue(oldItem.Priority),
+				NewValue: intValue(newItem.Priority),
+			})
+		}
+		if boolValue(oldItem.Enabled) != boolValue(newItem.Enabled) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Enabled",
+				OldValue: boolValue(oldItem.Enabled),
+				NewValue: boolValue(newItem.Enabled),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareDataObfuscationProfiles(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetDataObfuscationProfilesResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetDataObfuscationProfilesResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Data Obfuscation Profiles"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.DataObfuscationProfileResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s", *oldItem.Name)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if *oldItem.Enabled != *newItem.Enabled {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Enabled",
+				OldValue: strconv.FormatBool(*oldItem.Enabled),
+				NewValue: strconv.FormatBool(*newItem.Enabled),
+			})
+		}
+		if !stringSliceEqual(oldItem.DomainNames, newItem.DomainNames) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Domain Names",
+				OldValue: strings.Join(oldItem.DomainNames, ", "),
+				NewValue: strings.Join(newItem.DomainNames, ", "),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareDataObfuscationExpressions(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetDataObfuscationExpressionsResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetDataObfuscationExpressionsResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Data Obfuscation Expressions"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		record := item.(types.DataObfuscationExpressionResolved)
+		return matchKey(*record.Name, record.ProfileName)
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Profile: %s)", *oldItem.Name, oldItem.ProfileName)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Regex != *newItem.Regex {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Regex",
+				OldValue: *oldItem.Regex,
+				NewValue: *newItem.Regex,
+			})
+		}
+		if *oldItem.Property != *newItem.Property {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Property",
+				OldValue: *oldItem.Property,
+				NewValue: *newItem.Property,
+			})
+		}
+		if *oldItem.Enabled != *newItem.Enabled {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Enabled",
+				OldValue: strconv.FormatBool(*oldItem.Enabled),
+				NewValue: strconv.FormatBool(*newItem.Enabled),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareHistoricalCorrelationProfiles(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetHistoricalCorrelationProfilesResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetHistoricalCorrelationProfilesResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Historical Correlation Profiles"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.HistoricalCorrelationProfileResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Saved Search: %s)", *oldItem.Name, oldItem.SavedSearchName)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if oldItem.SavedSearchName != newItem.SavedSearchName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Saved Search",
+				OldValue: oldItem.SavedSearchName,
+				NewValue: newItem.SavedSearchName,
+			})
+		}
+		if oldItem.ScheduleSummary != newItem.ScheduleSummary {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Schedule",
+				OldValue: oldItem.ScheduleSummary,
+				NewValue: newItem.ScheduleSummary,
+			})
+		}
+		if *oldItem.Enabled != *newItem.Enabled {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Enabled",
+				OldValue: strconv.FormatBool(*oldItem.Enabled),
+				NewValue: strconv.FormatBool(*newItem.Enabled),
+			})
+		}
+		missingInOld, missingInNew, isEquals := listCompare(oldItem.RuleNames, newItem.RuleNames)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Rules (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareReportTemplates(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetReportTemplatesResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetReportTemplatesResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Reports"
+
+	// titles are only unique per owner, the owner tells reports with the same title apart
+	keyOf, err := configuredKey(report.ElementType, oldContent, tieBreakingKey(oldContent, newContent, func(item interface{}) string {
+		return stringValue(item.(types.ReportTemplateResolved).Title)
+	}, func(item interface{}) string {
+		return stringValue(item.(types.ReportTemplateResolved).Owner)
+	}))
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Title: %s (Owner: %s, Schedule: %s)", stringValue(oldItem.Title), stringValue(oldItem.Owner), oldItem.ScheduleSummary)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if stringValue(oldItem.Description) != stringValue(newItem.Description) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: stringValue(oldItem.Description),
+				NewValue: stringValue(newItem.Description),
+			})
+		}
+		if stringValue(oldItem.Owner) != stringValue(newItem.Owner) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Owner",
+				OldValue: stringValue(oldItem.Owner),
+				NewValue: stringValue(newItem.Owner),
+			})
+		}
+		if !stringSliceEqual(oldItem.GroupNames, newItem.GroupNames) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Report Groups",
+				OldValue: strings.Join(oldItem.GroupNames, ", "),
+				NewValue: strings.Join(newItem.GroupNames, ", "),
+			})
+		}
+		if oldItem.ScheduleSummary != newItem.ScheduleSummary {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Schedule",
+				OldValue: oldItem.ScheduleSummary,
+				NewValue: newItem.ScheduleSummary,
+			})
+		}
+		missingInOld, missingInNew, isEquals := listCompare(oldItem.Formats, newItem.Formats)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Formats (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+		missingInOld, missingInNew, isEquals = listCompare(oldItem.SavedSearchNames, newItem.SavedSearchNames)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Saved Searches (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareDashboards(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetDashboardsResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetDashboardsResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Dashboards"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		record := item.(types.DashboardResolved)
+		return matchKey(stringValue(record.Owner), stringValue(record.Name))
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Owner: %s, Dashboard: %s", stringValue(oldItem.Owner), stringValue(oldItem.Name))
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if boolValue(oldItem.Shared) != boolValue(newItem.Shared) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Shared",
+				OldValue: boolValue(oldItem.Shared),
+				NewValue: boolValue(newItem.Shared),
+			})
+		}
+		different.DifferentElements = append(different.DifferentElements, compareDashboardItems(oldItem.ItemsResolved, newItem.ItemsResolved)...)
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+// compareDashboardItems matches the widgets of a dashboard by name and returns the missing, added and reconfigured ones.
+func compareDashboardItems(oldItems, newItems []types.DashboardItemResolved) []types.DifferentElement {
+	var differentElements []types.DifferentElement
+
+	matches := matchRecords(oldItems, newItems, func(item interface{}) string {
+		return stringValue(item.(types.DashboardItemResolved).Name)
+	})
+
+	for oldIndex, oldItem := range oldItems {
+		itemName := stringValue(oldItem.Name)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			differentElements = append(differentElements, types.DifferentElement{
+				Name:     "Missing Widget",
+				OldValue: itemName,
+				NewValue: "",
+			})
+			continue
+		}
+		newItem := newItems[newIndex]
+
+		if stringValue(oldItem.ChartType) != stringValue(newItem.ChartType) {
+			differentElements = append(differentElements, types.DifferentElement{
+				Name:     "Widget " + itemName + ": Chart Type",
+				OldValue: stringValue(oldItem.ChartType),
+				NewValue: stringValue(newItem.ChartType),
+			})
+		}
+		if oldItem.SavedSearchName != newItem.SavedSearchName {
+			differentElements = append(differentElements, types.DifferentElement{
+				Name:     "Widget " + itemName + ": Saved Search",
+				OldValue: oldItem.SavedSearchName,
+				NewValue: newItem.SavedSearchName,
+			})
+		}
+		if stringValue(oldItem.TimeRange) != stringValue(newItem.TimeRange) {
+			differentElements = append(differentElements, types.DifferentElement{
+				Name:     "Widget " + itemName + ": Time Range",
+				OldValue: stringValue(oldItem.TimeRange),
+				NewValue: stringValue(newItem.TimeRange),
+			})
+		}
+	}
+
+	for _, newIndex := range matches.addedIndexes {
+		differentElements = append(differentElements, types.DifferentElement{
+			Name:     "Added Widget",
+			OldValue: "",
+			NewValue: stringValue(newItems[newIndex].Name),
+		})
+	}
+
+	return differentElements
+}
+
+// assetSampleSize is the number of assets of the old QRadar which are looked up in the new QRadar.
+const assetSampleSize = 500
+
+func CompareAssetProperties(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetCustomAssetProperties(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetCustomAssetProperties(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Asset Properties"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.AssetProperty).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s", *oldItem.Name)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.DataType != *newItem.DataType {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Data Type",
+				OldValue: *oldItem.DataType,
+				NewValue: *newItem.DataType,
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+// CompareAssetsSample looks up a sample of the old assets in the new QRadar by ip address, or hostname if the asset has no ip.
+func CompareAssetsSample(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetAssetsResolved(oldQRadar, assetSampleSize)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var ipAddresses, hostnames []string
+	for _, oldItem := range oldContent {
+		if len(oldItem.IPAddresses) > 0 {
+			ipAddresses = append(ipAddresses, oldItem.IPAddresses[0])
+		} else if len(oldItem.Hostnames) > 0 {
+			hostnames = append(hostnames, oldItem.Hostnames[0])
+		}
+	}
+
+	// only the sampled assets are looked up in the new QRadar
+	newContent, err := qradarenhanced.GetAssetsResolvedByAddress(newQRadar, ipAddresses, hostnames)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newAssets := make(map[string]types.AssetResolved)
+	for _, newItem := range newContent {
+		for _, ipAddress := range newItem.IPAddresses {
+			newAssets[matchKey(newItem.DomainName, ipAddress)] = newItem
+		}
+		for _, hostname := range newItem.Hostnames {
+			newAssets[matchKey(newItem.DomainName, hostname)] = newItem
+		}
+	}
+
+	var sameCount = 0
+	var unidentifiedCount = 0
+	var report = types.Report{}
+	report.ElementType = "Assets (Sample)"
+	report.Summary = append(report.Summary, fmt.Sprintf("sampled the first %d assets of the old QRadar", len(oldContent)))
+
+	for _, oldItem := range oldContent {
+		searchString := ""
+		if len(oldItem.IPAddresses) > 0 {
+			searchString = oldItem.IPAddresses[0]
+		} else if len(oldItem.Hostnames) > 0 {
+			searchString = oldItem.Hostnames[0]
+		} else {
+			// an asset without ip address and hostname cannot be looked up in the new QRadar
+			unidentifiedCount++
+			continue
+		}
+		itemName := fmt.Sprintf("Asset: %s (Domain: %s, Hostnames: %s)", searchString, oldItem.DomainName, strings.Join(oldItem.Hostnames, ", "))
+
+		newItem, ok := newAssets[matchKey(oldItem.DomainName, searchString)]
+		if !ok {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+
+		var different = types.DifferentRecord{}
+		if !stringSliceEqual(oldItem.IPAddresses, newItem.IPAddresses) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "IP Addresses",
+				OldValue: strings.Join(oldItem.IPAddresses, ", "),
+				NewValue: strings.Join(newItem.IPAddresses, ", "),
+			})
+		}
+		if !stringSliceEqual(oldItem.Hostnames, newItem.Hostnames) {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Hostnames",
+				OldValue: strings.Join(oldItem.Hostnames, ", "),
+				NewValue: strings.Join(newItem.Hostnames, ", "),
+			})
+		}
+		missingInOld, missingInNew, isEquals := listCompare(oldItem.Properties, newItem.Properties)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Properties (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	if unidentifiedCount > 0 {
+		report.Summary = append(report.Summary, fmt.Sprintf("assets without ip address and hostname (not compared): %d", unidentifiedCount))
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareVulnerabilityScanners(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetVulnerabilityScannersResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetVulnerabilityScannersResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Vulnerability Scanners"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.VulnerabilityScannerResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Type: %s)", *oldItem.Name, *oldItem.ScannerType)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if *oldItem.ScannerType != *newItem.ScannerType {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Scanner Type",
+				OldValue: *oldItem.ScannerType,
+				NewValue: *newItem.ScannerType,
+			})
+		}
+		if *oldItem.Host != *newItem.Host {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Host",
+				OldValue: *oldItem.Host,
+				NewValue: *newItem.Host,
+			})
+		}
+		if oldItem.Credentials != newItem.Credentials {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Credentials",
+				OldValue: oldItem.Credentials,
+				NewValue: newItem.Credentials,
+			})
+		}
+		if oldItem.ScheduleSummary != newItem.ScheduleSummary {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Schedule",
+				OldValue: oldItem.ScheduleSummary,
+				NewValue: newItem.ScheduleSummary,
+			})
+		}
+		missingInOld, missingInNew, isEquals := listCompare(oldItem.CIDRRanges, newItem.CIDRRanges)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing CIDR Targets (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareScanProfiles(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetScanProfilesResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetScanProfilesResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Scan Profiles"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.ScanProfileResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Scanner: %s)", *oldItem.Name, oldItem.ScannerName)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if *oldItem.ScanType != *newItem.ScanType {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Scan Type",
+				OldValue: *oldItem.ScanType,
+				NewValue: *newItem.ScanType,
+			})
+		}
+		if oldItem.ScannerName != newItem.ScannerName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Scanner",
+				OldValue: oldItem.ScannerName,
+				NewValue: newItem.ScannerName,
+			})
+		}
+		if oldItem.Credentials != newItem.Credentials {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Credentials",
+				OldValue: oldItem.Credentials,
+				NewValue: newItem.Credentials,
+			})
+		}
+		if oldItem.ScheduleSummary != newItem.ScheduleSummary {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Schedule",
+				OldValue: oldItem.ScheduleSummary,
+				NewValue: newItem.ScheduleSummary,
+			})
+		}
+		missingInOld, missingInNew, isEquals := listCompare(oldItem.IPs, newItem.IPs)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Targets (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	return report, nil
+}
+
+func CompareCustomActions(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetCustomActionsResolved(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetCustomActionsResolved(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var report = types.Report{}
+	report.ElementType = "Custom Actions"
+
+	keyOf, err := configuredKey(report.ElementType, oldContent, func(item interface{}) string {
+		return *item.(types.CustomActionResolved).Name
+	})
+	if err != nil {
+		return types.Report{}, err
+	}
+	matches := matchRecords(oldContent, newContent, keyOf)
+	matches.matchRenamed(report.ElementType)
+	report.AmbiguousMatches = matches.ambiguousMatches(oldContent, newContent)
+	report.RenamedRecords = matches.renamedRecords()
+
+	for oldIndex, oldItem := range oldContent {
+		itemName := fmt.Sprintf("Name: %s (Interpreter: %s)", *oldItem.Name, oldItem.InterpreterName)
+		newIndex := matches.newIndexes[oldIndex]
+		if newIndex < 0 {
+			report.MissingRecords = append(report.MissingRecords, itemName)
+			continue
+		}
+		newItem := newContent[newIndex]
+
+		var different = types.DifferentRecord{}
+		if *oldItem.Description != *newItem.Description {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Description",
+				OldValue: *oldItem.Description,
+				NewValue: *newItem.Description,
+			})
+		}
+		if oldItem.InterpreterName != newItem.InterpreterName {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Interpreter",
+				OldValue: oldItem.InterpreterName,
+				NewValue: newItem.InterpreterName,
+			})
+		}
+		if oldItem.ScriptChecksum != newItem.ScriptChecksum {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Script Checksum (SHA-256)",
+				OldValue: oldItem.ScriptChecksum,
+				NewValue: newItem.ScriptChecksum,
+			})
+		}
+		missingInOld, missingInNew, isEquals := listCompare(oldItem.ParameterNames, newItem.ParameterNames)
+		if !isEquals {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Missing Parameters (missing in Old/New)",
+				OldValue: strings.Join(missingInOld, "\n"),
+				NewValue: strings.Join(missingInNew, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = itemName
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		} else {
+			sameCount++
+		}
+	}
+	report.SameCount = sameCount
+	report.OldCount = len(oldContent)
+	report.NewCount = len(newContent)
+
+	oldRuleCustomActions, err := qradarenhanced.GetRuleCustomActions(oldQRadar, oldContent)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newRuleCustomActions, err := qradarenhanced.GetRuleCustomActions(newQRadar, newContent)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newCustomActionNames := make(map[string]bool)
+	for _, newItem := range newContent {
+		newCustomActionNames[*newItem.Name] = true
+	}
+
+	var ruleNames []string
+	for ruleName := range oldRuleCustomActions {
+		ruleNames = append(ruleNames, ruleName)
+	}
+	sort.Strings(ruleNames)
+	for _, ruleName := range ruleNames {
+		for _, customActionName := range oldRuleCustomActions[ruleName] {
+			if !newCustomActionNames[customActionName] {
+				report.Summary = append(report.Summary, fmt.Sprintf("rule %s uses custom action %s which does not exist in new QRadar", ruleName, customActionName))
+			}
+		}
+	}
+
+	ruleNames = nil
+	for ruleName := range newRuleCustomActions {
+		ruleNames = append(ruleNames, ruleName)
+	}
+	sort.Strings(ruleNames)
+	for _, ruleName := range ruleNames {
+		for _, customActionName := range newRuleCustomActions[ruleName] {
+			if !newCustomActionNames[customActionName] {
+				report.Summary = append(report.Summary, fmt.Sprintf("rule %s in new QRadar has a response with an %s", ruleName, customActionName))
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// expectedDifferentSystemKeys are configuration keys which always differ between two installations.
+// More keys can be configured with config.Config.ExpectedDifferentSystemKeys.
+var expectedDifferentSystemKeys = []string{
+	"id", "hostname", "host_name", "ip", "ip_address", "private_ip", "public_ip", "appliance_serial",
+	"creation_date", "modification_date", "last_modified", "server_id", "managed_host_id",
+}
+
+func CompareSystemConfiguration(oldQRadar *qradar.Client, newQRadar *qradar.Client) (types.Report, error) {
+	oldContent, err := qradarenhanced.GetSystemConfiguration(oldQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	newContent, err := qradarenhanced.GetSystemConfiguration(newQRadar)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	var sameCount = 0
+	var expectedCount = 0
+	var report = types.Report{}
+	report.ElementType = "System Configuration"
+
+	var sections []string
+	for section := range oldContent {
+		sections = append(sections, section)
+	}
+	for section := range newContent {
+		if _, ok := oldContent[section]; !ok {
+			sections = append(sections, section)
+		}
+	}
+	sort.Strings(sections)
 
-	for _, newItem := range newList {
-		exists := false
-		for _, oldItem := range oldList {
-			if newItem == oldItem {
-				exists = true
-				break
+	for _, section := range sections {
+		oldSection, isInOld := oldContent[section]
+		newSection, isInNew := newContent[section]
+		if !isInOld {
+			report.Summary = append(report.Summary, fmt.Sprintf("section %s is not available in the old QRadar", section))
+		}
+		if !isInNew {
+			// the summary line reports the whole section, its keys are not listed as missing records
+			report.Summary = append(report.Summary, fmt.Sprintf("section %s is not available in the new QRadar", section))
+			report.OldCount += len(oldSection)
+			continue
+		}
+
+		var keys []string
+		for key := range oldSection {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var different = types.DifferentRecord{}
+		for _, key := range keys {
+			report.OldCount++
+			newValue, ok := newSection[key]
+			if isExpectedDifferentSystemKey(key) {
+				expectedCount++
+				continue
+			}
+			if !ok {
+				report.MissingRecords = append(report.MissingRecords, section+": "+key)
+				continue
+			}
+			if oldSection[key] != newValue {
+				different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+					Name:     key,
+					OldValue: oldSection[key],
+					NewValue: newValue,
+				})
+			} else {
+				sameCount++
+			}
+		}
+
+		var addedKeys []string
+		for key, newValue := range newSection {
+			if _, ok := oldSection[key]; !ok && !isExpectedDifferentSystemKey(key) {
+				addedKeys = append(addedKeys, key+": "+newValue)
+			}
+		}
+		sort.Strings(addedKeys)
+		if len(addedKeys) > 0 {
+			different.DifferentElements = append(different.DifferentElements, types.DifferentElement{
+				Name:     "Added Keys (missing in Old)",
+				NewValue: strings.Join(addedKeys, "\n"),
+			})
+		}
+
+		if len(different.DifferentElements) > 0 {
+			different.RecordName = "Section: " + section
+			report.DifferentRecords = append(report.DifferentRecords, different)
+		}
+
+		report.NewCount += len(newSection)
+	}
+	report.Summary = append(report.Summary, fmt.Sprintf("keys expected to differ (not compared): %d", expectedCount))
+	report.SameCount = sameCount
+
+	return report, nil
+}
+
+func isExpectedDifferentSystemKey(key string) bool {
+	lastKey := key
+	if index := strings.LastIndexAny(key, ".]"); index >= 0 {
+		lastKey = key[index+1:]
+	}
+	for _, expectedKeys := range [][]string{expectedDifferentSystemKeys, configuration.ExpectedDifferentSystemKeys} {
+		for _, expectedKey := range expectedKeys {
+			if strings.EqualFold(lastKey, expectedKey) {
+				return true
 			}
 		}
-		if !exists {
-			missingInOld = append(missingInOld, newItem)
+	}
+	return false
+}
+
+func propertyExpressionRegexToString(expression types.PropertyExpressionRegexResolved) string {
+	return fmt.Sprintf("Log Source Type: %s, Log Source: %s, Low Level Category: %s, QID: %s, Regex: %s, Capture Group: %d, Enabled: %t",
+		expression.LogSourceTypeName, expression.LogSourceName, expression.LowLevelCategoryName, expression.QidName,
+		*expression.Regex, *expression.CaptureGroup, *expression.Enabled)
+}
+
+// listCompare compares two lists independent of their order and returns the items missing in the old and new list.
+// The lists of the caller are not modified.
+func listCompare(oldList, newList []string) ([]string, []string, bool) {
+	oldList = append([]string(nil), oldList...)
+	newList = append([]string(nil), newList...)
+	sort.Strings(oldList)
+	sort.Strings(newList)
+
+	if stringSliceEqual(oldList, newList) {
+		return nil, nil, true
+	}
+
+	matches := matchRecords(oldList, newList, func(item interface{}) string {
+		return item.(string)
+	})
+
+	var missingInOld []string
+	var missingInNew []string
+	for oldIndex, oldItem := range oldList {
+		if matches.newIndexes[oldIndex] < 0 {
+			missingInNew = append(missingInNew, oldItem)
 		}
 	}
+	for _, newIndex := range matches.addedIndexes {
+		missingInOld = append(missingInOld, newList[newIndex])
+	}
 
 	return missingInOld, missingInNew, false
 }
+
+// stringValue returns the value of an optional api field or "" if it is not set.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// boolValue returns the value of an optional api field as string or "" if it is not set.
+func boolValue(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*value)
+}
+
+// intValue returns the value of an optional api field as string or "" if it is not set.
+func intValue(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
 func stringSliceEqual(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -799,3 +2892,37 @@ func stringSliceEqual(a, b []string) bool {
 	}
 	return true
 }
+
+// compareVersions compares dotted version strings numerically and returns 1 if a is newer, -1 if b is newer and 0 if they are equal.
+func compareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		aPart, bPart := "0", "0"
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+
+		aNumber, aErr := strconv.Atoi(aPart)
+		bNumber, bErr := strconv.Atoi(bPart)
+		if aErr == nil && bErr == nil {
+			if aNumber != bNumber {
+				if aNumber > bNumber {
+					return 1
+				}
+				return -1
+			}
+			continue
+		}
+		if aPart != bPart {
+			if aPart > bPart {
+				return 1
+			}
+			return -1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,116 @@
+package comparator
+
+import (
+	"github.com/ilyaglow/go-qradar"
+	"qradar-content-compare/types"
+	"reflect"
+	"testing"
+)
+
+func TestListCompare(t *testing.T) {
+	tests := []struct {
+		name             string
+		oldList          []string
+		newList          []string
+		wantMissingInOld []string
+		wantMissingInNew []string
+		wantEquals       bool
+	}{
+		{name: "equal", oldList: []string{"a", "b"}, newList: []string{"a", "b"}, wantEquals: true},
+		{name: "reordered", oldList: []string{"b", "a"}, newList: []string{"a", "b"}, wantEquals: true},
+		{name: "empty and nil", oldList: []string{}, newList: nil, wantEquals: true},
+		{name: "missing in new", oldList: []string{"c", "a", "b"}, newList: []string{"b"}, wantMissingInNew: []string{"a", "c"}},
+		{name: "missing in old", oldList: []string{"b"}, newList: []string{"c", "b", "a"}, wantMissingInOld: []string{"a", "c"}},
+		{
+			name:             "same characters in other items",
+			oldList:          []string{"ab", "c"},
+			newList:          []string{"a", "bc"},
+			wantMissingInOld: []string{"a", "bc"},
+			wantMissingInNew: []string{"ab", "c"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldList := append([]string(nil), test.oldList...)
+			newList := append([]string(nil), test.newList...)
+			missingInOld, missingInNew, isEquals := listCompare(oldList, newList)
+			if isEquals != test.wantEquals {
+				t.Errorf("isEquals = %t, want %t", isEquals, test.wantEquals)
+			}
+			if !reflect.DeepEqual(missingInOld, test.wantMissingInOld) {
+				t.Errorf("missingInOld = %v, want %v", missingInOld, test.wantMissingInOld)
+			}
+			if !reflect.DeepEqual(missingInNew, test.wantMissingInNew) {
+				t.Errorf("missingInNew = %v, want %v", missingInNew, test.wantMissingInNew)
+			}
+			if !stringSliceEqual(oldList, test.oldList) || !stringSliceEqual(newList, test.newList) {
+				t.Errorf("listCompare() modified the lists to %v and %v", oldList, newList)
+			}
+		})
+	}
+}
+
+func logSourceGroup(name string, parentID int, parentGroupName string) types.LogSourceGroupsResolved {
+	return types.LogSourceGroupsResolved{
+		LogSourceGroup:  qradar.LogSourceGroup{Name: &name, ParentID: &parentID},
+		ParentGroupName: parentGroupName,
+	}
+}
+
+func TestLogSourceGroupKey(t *testing.T) {
+	tests := []struct {
+		name             string
+		oldContent       []types.LogSourceGroupsResolved
+		newContent       []types.LogSourceGroupsResolved
+		wantNewIndexes   []int
+		wantAddedIndexes []int
+	}{
+		{
+			name:           "root groups with renamed root group",
+			oldContent:     []types.LogSourceGroupsResolved{logSourceGroup("Windows", 1, "Other")},
+			newContent:     []types.LogSourceGroupsResolved{logSourceGroup("Windows", 1, "Root")},
+			wantNewIndexes: []int{0},
+		},
+		{
+			name:           "root group moved below another group",
+			oldContent:     []types.LogSourceGroupsResolved{logSourceGroup("Windows", 1, "Other")},
+			newContent:     []types.LogSourceGroupsResolved{logSourceGroup("Windows", 5, "Servers")},
+			wantNewIndexes: []int{0},
+		},
+		{
+			name:       "root group preferred over first group of the same name",
+			oldContent: []types.LogSourceGroupsResolved{logSourceGroup("Windows", 1, "Other")},
+			newContent: []types.LogSourceGroupsResolved{
+				logSourceGroup("Windows", 5, "Servers"),
+				logSourceGroup("Windows", 1, "Other"),
+			},
+			wantNewIndexes:   []int{1},
+			wantAddedIndexes: []int{0},
+		},
+		{
+			name:             "group moved below another parent",
+			oldContent:       []types.LogSourceGroupsResolved{logSourceGroup("Windows", 5, "Servers")},
+			newContent:       []types.LogSourceGroupsResolved{logSourceGroup("Windows", 6, "Clients")},
+			wantNewIndexes:   []int{-1},
+			wantAddedIndexes: []int{0},
+		},
+		{
+			name:             "group moved to the root group",
+			oldContent:       []types.LogSourceGroupsResolved{logSourceGroup("Windows", 5, "Servers")},
+			newContent:       []types.LogSourceGroupsResolved{logSourceGroup("Windows", 1, "Other")},
+			wantNewIndexes:   []int{-1},
+			wantAddedIndexes: []int{0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matchRecords(test.oldContent, test.newContent, logSourceGroupKey(test.newContent))
+			if !reflect.DeepEqual(result.newIndexes, test.wantNewIndexes) {
+				t.Errorf("newIndexes = %v, want %v", result.newIndexes, test.wantNewIndexes)
+			}
+			if !reflect.DeepEqual(result.addedIndexes, test.wantAddedIndexes) {
+				t.Errorf("addedIndexes = %v, want %v", result.addedIndexes, test.wantAddedIndexes)
+			}
+		})
+	}
+}
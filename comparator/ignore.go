@@ -0,0 +1,101 @@
+package comparator
+
+import (
+	"qradar-content-compare/config"
+	"qradar-content-compare/types"
+)
+
+// ApplyIgnoreRules removes the differences, summary lines, ambiguous matches and renamed records suppressed by the
+// ignore rules of the configuration from the report and counts them as suppressed. It returns false if the whole
+// report is suppressed.
+func ApplyIgnoreRules(report types.Report) (types.Report, bool) {
+	for _, ignoreRule := range configuration.IgnoreRules {
+		if ignoreRule.SuppressesReport(report.ElementType) {
+			return report, false
+		}
+	}
+	if len(configuration.IgnoreRules) == 0 {
+		return report, true
+	}
+
+	var summary []string
+	for _, summaryLine := range report.Summary {
+		if isSuppressedElement(report.ElementType, summaryLine, config.SummaryField) {
+			report.SuppressedCount++
+			continue
+		}
+		summary = append(summary, summaryLine)
+	}
+	report.Summary = summary
+
+	var missingRecords []string
+	for _, missingRecord := range report.MissingRecords {
+		if isSuppressedRecord(report.ElementType, missingRecord) {
+			report.SuppressedCount++
+			continue
+		}
+		missingRecords = append(missingRecords, missingRecord)
+	}
+	report.MissingRecords = missingRecords
+
+	var differentRecords []types.DifferentRecord
+	for _, differentRecord := range report.DifferentRecords {
+		var differentElements []types.DifferentElement
+		for _, differentElement := range differentRecord.DifferentElements {
+			if isSuppressedElement(report.ElementType, differentRecord.RecordName, differentElement.Name) {
+				report.SuppressedCount++
+				continue
+			}
+			differentElements = append(differentElements, differentElement)
+		}
+
+		// a record without any remaining difference is as expected
+		if len(differentElements) == 0 {
+			report.SameCount++
+			continue
+		}
+		differentRecord.DifferentElements = differentElements
+		differentRecords = append(differentRecords, differentRecord)
+	}
+	report.DifferentRecords = differentRecords
+
+	var ambiguousMatches []types.AmbiguousMatch
+	for _, ambiguousMatch := range report.AmbiguousMatches {
+		if isSuppressedElement(report.ElementType, ambiguousMatch.Key, config.AmbiguousMatchField) {
+			report.SuppressedCount++
+			continue
+		}
+		ambiguousMatches = append(ambiguousMatches, ambiguousMatch)
+	}
+	report.AmbiguousMatches = ambiguousMatches
+
+	var renamedRecords []types.RenamedRecord
+	for _, renamedRecord := range report.RenamedRecords {
+		if isSuppressedElement(report.ElementType, renamedRecord.OldName, config.RenamedRecordField) {
+			report.SuppressedCount++
+			continue
+		}
+		renamedRecords = append(renamedRecords, renamedRecord)
+	}
+	report.RenamedRecords = renamedRecords
+
+	return report, true
+}
+
+func isSuppressedRecord(reportType, recordName string) bool {
+	for _, ignoreRule := range configuration.IgnoreRules {
+		if ignoreRule.SuppressesRecord(reportType, recordName) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSuppressedElement(reportType, recordName, elementName string) bool {
+	for _, ignoreRule := range configuration.IgnoreRules {
+		if ignoreRule.SuppressesElement(reportType, recordName, elementName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,333 @@
+package comparator
+
+import (
+	"io/ioutil"
+	"os"
+	"qradar-content-compare/config"
+	"qradar-content-compare/types"
+	"reflect"
+	"testing"
+)
+
+// loadIgnoreRules writes the ignore file content to a temporary file and loads it.
+func loadIgnoreRules(t *testing.T, content string) ([]config.IgnoreRule, error) {
+	t.Helper()
+	file, err := ioutil.TempFile("", "qradar_compare_ignore_*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return config.LoadIgnoreRules(file.Name())
+}
+
+func TestLoadIgnoreRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "empty list", content: `[]`, wantCount: 0},
+		{name: "report only", content: `[{"report": "Assets (Sample)"}]`, wantCount: 1},
+		{name: "all patterns", content: `[{"report": "Rules", "record": "Rule Name: *", "field": "Rule Enabled", "comment": "test"}]`, wantCount: 1},
+		{name: "record regex", content: `[{"record_regex": "^Name: (A|B)$"}]`, wantCount: 1},
+		{name: "record and record regex", content: `[{"record": "Name: A", "record_regex": "^Name: A$"}]`, wantErr: true},
+		{name: "invalid record regex", content: `[{"record_regex": "("}]`, wantErr: true},
+		{name: "comment only", content: `[{"comment": "todo"}]`, wantErr: true},
+		{name: "empty rule", content: `[{"report": "Rules"}, {}]`, wantErr: true},
+		{name: "invalid json", content: `{"report": "Rules"}`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ignoreRules, err := loadIgnoreRules(t, test.content)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LoadIgnoreRules() error = %v, want error %t", err, test.wantErr)
+			}
+			if len(ignoreRules) != test.wantCount {
+				t.Errorf("LoadIgnoreRules() loaded %d rules, want %d", len(ignoreRules), test.wantCount)
+			}
+		})
+	}
+}
+
+func TestIgnoreRuleGlobs(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		reportType  string
+		recordName  string
+		elementName string
+		wantReport  bool
+		wantRecord  bool
+		wantElement bool
+	}{
+		{
+			name:        "report glob",
+			content:     `[{"report": "Custom Properties*"}]`,
+			reportType:  "Custom Properties (JSON)",
+			wantReport:  true,
+			wantRecord:  true,
+			wantElement: true,
+		},
+		{
+			name:       "report glob is anchored",
+			content:    `[{"report": "Properties"}]`,
+			reportType: "Custom Properties",
+		},
+		{
+			name:        "report glob ignores regex characters",
+			content:     `[{"report": "Custom Properties (JSON)"}]`,
+			reportType:  "Custom Properties (JSON)",
+			wantReport:  true,
+			wantRecord:  true,
+			wantElement: true,
+		},
+		{
+			name:        "record glob with question mark",
+			content:     `[{"report": "Log Sources", "record": "Name: ?"}]`,
+			reportType:  "Log Sources",
+			recordName:  "Name: A",
+			elementName: "Status",
+			wantRecord:  true,
+			wantElement: true,
+		},
+		{
+			name:        "question mark matches one character",
+			content:     `[{"report": "Log Sources", "record": "Name: ?"}]`,
+			reportType:  "Log Sources",
+			recordName:  "Name: AB",
+			elementName: "Status",
+		},
+		{
+			name:        "star matches line breaks",
+			content:     `[{"record": "Name: *"}]`,
+			reportType:  "Log Sources",
+			recordName:  "Name: A\nB",
+			elementName: "Status",
+			wantRecord:  true,
+			wantElement: true,
+		},
+		{
+			name:        "record regex is not anchored",
+			content:     `[{"record_regex": "Test"}]`,
+			reportType:  "Rules",
+			recordName:  "Rule Name: Test Rule",
+			elementName: "Rule Enabled",
+			wantRecord:  true,
+			wantElement: true,
+		},
+		{
+			name:        "field rule suppresses only the element",
+			content:     `[{"field": "* Rate Limit"}]`,
+			reportType:  "Tenants",
+			recordName:  "Name: Tenant",
+			elementName: "Event Rate Limit",
+			wantElement: true,
+		},
+		{
+			name:        "field glob is anchored",
+			content:     `[{"field": "Rate Limit"}]`,
+			reportType:  "Tenants",
+			recordName:  "Name: Tenant",
+			elementName: "Event Rate Limit",
+		},
+		{
+			name:        "field rule without report matches every report",
+			content:     `[{"field": "Description"}]`,
+			reportType:  "Rules",
+			recordName:  "Rule Name: Test",
+			elementName: "Description",
+			wantElement: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ignoreRules, err := loadIgnoreRules(t, test.content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ignoreRule := ignoreRules[0]
+			if got := ignoreRule.SuppressesReport(test.reportType); got != test.wantReport {
+				t.Errorf("SuppressesReport() = %t, want %t", got, test.wantReport)
+			}
+			if got := ignoreRule.SuppressesRecord(test.reportType, test.recordName); got != test.wantRecord {
+				t.Errorf("SuppressesRecord() = %t, want %t", got, test.wantRecord)
+			}
+			if got := ignoreRule.SuppressesElement(test.reportType, test.recordName, test.elementName); got != test.wantElement {
+				t.Errorf("SuppressesElement() = %t, want %t", got, test.wantElement)
+			}
+		})
+	}
+}
+
+func ignoreTestReport() types.Report {
+	return types.Report{
+		ElementType:    "Log Sources",
+		Summary:        []string{"older in new QRadar: WinCollect (7.2 < 7.3)"},
+		SameCount:      1,
+		MissingRecords: []string{"Name: A", "Name: B"},
+		DifferentRecords: []types.DifferentRecord{
+			{RecordName: "Name: AB", DifferentElements: []types.DifferentElement{{Name: "Status"}, {Name: "Description"}}},
+			{RecordName: "Name: C", DifferentElements: []types.DifferentElement{{Name: "Status"}}},
+		},
+		AmbiguousMatches: []types.AmbiguousMatch{{QRadar: "new", Key: "Duplicate", RecordCount: 2}},
+		RenamedRecords:   []types.RenamedRecord{{OldName: "Old", NewName: "New", Confidence: 0.9}},
+	}
+}
+
+func TestApplyIgnoreRules(t *testing.T) {
+	tests := []struct {
+		name               string
+		content            string
+		wantOk             bool
+		wantSummary        int
+		wantMissing        []string
+		wantDifferent      []string
+		wantSameCount      int
+		wantSuppressed     int
+		wantAmbiguous      int
+		wantRenamed        int
+		wantElementsOfName map[string]int
+	}{
+		{
+			name:          "no rules",
+			content:       `[]`,
+			wantOk:        true,
+			wantSummary:   1,
+			wantMissing:   []string{"Name: A", "Name: B"},
+			wantDifferent: []string{"Name: AB", "Name: C"},
+			wantSameCount: 1,
+			wantAmbiguous: 1,
+			wantRenamed:   1,
+		},
+		{
+			name:    "report rule suppresses the report",
+			content: `[{"report": "Log*"}]`,
+			wantOk:  false,
+		},
+		{
+			name:          "rule of another report",
+			content:       `[{"report": "Tenants"}, {"report": "Tenants", "field": "Status"}]`,
+			wantOk:        true,
+			wantSummary:   1,
+			wantMissing:   []string{"Name: A", "Name: B"},
+			wantDifferent: []string{"Name: AB", "Name: C"},
+			wantSameCount: 1,
+			wantAmbiguous: 1,
+			wantRenamed:   1,
+		},
+		{
+			name:               "field rule suppresses differences and counts emptied records as ok",
+			content:            `[{"report": "Log Sources", "field": "Status"}]`,
+			wantOk:             true,
+			wantSummary:        1,
+			wantMissing:        []string{"Name: A", "Name: B"},
+			wantDifferent:      []string{"Name: AB"},
+			wantSameCount:      2,
+			wantSuppressed:     2,
+			wantAmbiguous:      1,
+			wantRenamed:        1,
+			wantElementsOfName: map[string]int{"Name: AB": 1},
+		},
+		{
+			name:           "record rule suppresses the missing and different record",
+			content:        `[{"report": "Log Sources", "record_regex": "^Name: A"}]`,
+			wantOk:         true,
+			wantSummary:    1,
+			wantMissing:    []string{"Name: B"},
+			wantDifferent:  []string{"Name: C"},
+			wantSameCount:  2,
+			wantSuppressed: 3,
+			wantAmbiguous:  1,
+			wantRenamed:    1,
+		},
+		{
+			name:           "record glob is anchored",
+			content:        `[{"report": "Log Sources", "record": "Name: A"}]`,
+			wantOk:         true,
+			wantSummary:    1,
+			wantMissing:    []string{"Name: B"},
+			wantDifferent:  []string{"Name: AB", "Name: C"},
+			wantSameCount:  1,
+			wantSuppressed: 1,
+			wantAmbiguous:  1,
+			wantRenamed:    1,
+		},
+		{
+			name: "summary, ambiguous match and renamed record rules",
+			content: `[{"record": "older in new QRadar: *", "field": "Summary"},
+				{"record": "Duplicate", "field": "Ambiguous Match"},
+				{"record": "Old", "field": "Renamed Record"}]`,
+			wantOk:         true,
+			wantMissing:    []string{"Name: A", "Name: B"},
+			wantDifferent:  []string{"Name: AB", "Name: C"},
+			wantSameCount:  1,
+			wantSuppressed: 3,
+		},
+		{
+			name:           "summary field does not suppress differences",
+			content:        `[{"field": "Summary"}]`,
+			wantOk:         true,
+			wantMissing:    []string{"Name: A", "Name: B"},
+			wantDifferent:  []string{"Name: AB", "Name: C"},
+			wantSameCount:  1,
+			wantSuppressed: 1,
+			wantAmbiguous:  1,
+			wantRenamed:    1,
+		},
+	}
+	defer func() {
+		configuration.IgnoreRules = nil
+	}()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ignoreRules, err := loadIgnoreRules(t, test.content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			configuration.IgnoreRules = ignoreRules
+
+			report, ok := ApplyIgnoreRules(ignoreTestReport())
+			if ok != test.wantOk {
+				t.Fatalf("ApplyIgnoreRules() ok = %t, want %t", ok, test.wantOk)
+			}
+			if !ok {
+				return
+			}
+			var different []string
+			for _, differentRecord := range report.DifferentRecords {
+				different = append(different, differentRecord.RecordName)
+				if want, ok := test.wantElementsOfName[differentRecord.RecordName]; ok && len(differentRecord.DifferentElements) != want {
+					t.Errorf("%s has %d elements, want %d", differentRecord.RecordName, len(differentRecord.DifferentElements), want)
+				}
+			}
+			if len(report.Summary) != test.wantSummary {
+				t.Errorf("Summary = %v, want %d lines", report.Summary, test.wantSummary)
+			}
+			if !reflect.DeepEqual(report.MissingRecords, test.wantMissing) {
+				t.Errorf("MissingRecords = %v, want %v", report.MissingRecords, test.wantMissing)
+			}
+			if !reflect.DeepEqual(different, test.wantDifferent) {
+				t.Errorf("DifferentRecords = %v, want %v", different, test.wantDifferent)
+			}
+			if report.SameCount != test.wantSameCount {
+				t.Errorf("SameCount = %d, want %d", report.SameCount, test.wantSameCount)
+			}
+			if report.SuppressedCount != test.wantSuppressed {
+				t.Errorf("SuppressedCount = %d, want %d", report.SuppressedCount, test.wantSuppressed)
+			}
+			if len(report.AmbiguousMatches) != test.wantAmbiguous {
+				t.Errorf("AmbiguousMatches = %v, want %d", report.AmbiguousMatches, test.wantAmbiguous)
+			}
+			if len(report.RenamedRecords) != test.wantRenamed {
+				t.Errorf("RenamedRecords = %v, want %d", report.RenamedRecords, test.wantRenamed)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package comparator
+
+import (
+	"encoding/json"
+	"fmt"
+	"qradar-content-compare/types"
+	"reflect"
+	"strings"
+)
+
+// matchResult holds the outcome of matching the old records against the new records.
+type matchResult struct {
+	// newIndexes contains for every old record the index of the matched new record or -1 if it is missing.
+	newIndexes []int
+	// addedIndexes contains the indexes of the new records without an old record.
+	addedIndexes  []int
+	oldDuplicates []duplicateKey
+	newDuplicates []duplicateKey
+	// renamed contains the records paired by matchRenamed by their old index.
+	renamed map[int]types.RenamedRecord
+
+	oldValues reflect.Value
+	newValues reflect.Value
+	keyOf     func(item interface{}) string
+}
+
+// duplicateKey is a key which is used by more than one record of the same QRadar.
+type duplicateKey struct {
+	Key     string
+	Indexes []int
+}
+
+// matchRecords matches the records of the oldContent and newContent slices by the key returned by keyOf.
+// The new records are indexed once, so matching is O(n). Records sharing a key are paired in the order
+// they are returned by the api and the key is reported as duplicate.
+func matchRecords(oldContent, newContent interface{}, keyOf func(item interface{}) string) matchResult {
+	oldValues := reflect.ValueOf(oldContent)
+	newValues := reflect.ValueOf(newContent)
+
+	newKeys, newIndex := indexRecords(newValues, keyOf)
+	oldKeys, oldIndex := indexRecords(oldValues, keyOf)
+
+	result := matchResult{
+		newIndexes: make([]int, oldValues.Len()),
+		oldValues:  oldValues,
+		newValues:  newValues,
+		keyOf:      keyOf,
+	}
+
+	isMatched := make([]bool, newValues.Len())
+	for _, key := range oldKeys {
+		candidates := newIndex[key]
+		for occurrence, oldIndex := range oldIndex[key] {
+			if occurrence < len(candidates) {
+				result.newIndexes[oldIndex] = candidates[occurrence]
+				isMatched[candidates[occurrence]] = true
+			} else {
+				result.newIndexes[oldIndex] = -1
+			}
+		}
+	}
+	for index, matched := range isMatched {
+		if !matched {
+			result.addedIndexes = append(result.addedIndexes, index)
+		}
+	}
+
+	result.oldDuplicates = findDuplicates(oldKeys, oldIndex)
+	result.newDuplicates = findDuplicates(newKeys, newIndex)
+
+	return result
+}
+
+// configuredKey returns the key function for the fields configured for the report type or keyOf if there is no
+// configuration. The fields are checked against the record type of content, so a typo fails before any comparison.
+func configuredKey(reportType string, content interface{}, keyOf func(item interface{}) string) (func(item interface{}) string, error) {
+	fieldNames, ok := configuration.MatchKeys[reportType]
+	if !ok {
+		return keyOf, nil
+	}
+	if len(fieldNames) == 0 {
+		return nil, fmt.Errorf("no match key fields configured for %s", reportType)
+	}
+
+	recordType := reflect.TypeOf(content).Elem()
+	var fieldIndexes [][]int
+	for _, fieldName := range fieldNames {
+		fieldIndex, ok := findField(recordType, fieldName)
+		if !ok {
+			return nil, fmt.Errorf("unknown match key field %s for %s", fieldName, reportType)
+		}
+		fieldIndexes = append(fieldIndexes, fieldIndex)
+	}
+
+	return func(item interface{}) string {
+		value := reflect.ValueOf(item)
+		var fields []string
+		for _, fieldIndex := range fieldIndexes {
+			fields = append(fields, formatField(value.FieldByIndex(fieldIndex)))
+		}
+		return matchKey(fields...)
+	}, nil
+}
+
+// findField returns the index of the exported field with the given struct or json name, ignoring case.
+// Embedded structs are searched after the fields of the record itself.
+func findField(recordType reflect.Type, name string) ([]int, bool) {
+	if recordType.Kind() != reflect.Struct {
+		return nil, false
+	}
+	for i := 0; i < recordType.NumField(); i++ {
+		field := recordType.Field(i)
+		if field.PkgPath != "" || field.Anonymous {
+			continue
+		}
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if strings.EqualFold(field.Name, name) || (jsonName != "" && strings.EqualFold(jsonName, name)) {
+			return []int{i}, true
+		}
+	}
+	for i := 0; i < recordType.NumField(); i++ {
+		field := recordType.Field(i)
+		if !field.Anonymous {
+			continue
+		}
+		if fieldIndex, ok := findField(field.Type, name); ok {
+			return append([]int{i}, fieldIndex...), true
+		}
+	}
+	return nil, false
+}
+
+// tieBreakingKey returns a key function which uses keyOf and adds tieBreak only for the keys shared by more than one
+// record of a QRadar, so duplicates are told apart without making the key of all other records stricter.
+func tieBreakingKey(oldContent, newContent interface{}, keyOf, tieBreak func(item interface{}) string) func(item interface{}) string {
+	isDuplicate := make(map[string]bool)
+	for _, content := range []interface{}{oldContent, newContent} {
+		_, index := indexRecords(reflect.ValueOf(content), keyOf)
+		for key, indexes := range index {
+			if len(indexes) > 1 {
+				isDuplicate[key] = true
+			}
+		}
+	}
+
+	return func(item interface{}) string {
+		key := keyOf(item)
+		if isDuplicate[key] {
+			return matchKey(key, tieBreak(item))
+		}
+		return key
+	}
+}
+
+// indexRecords returns the distinct keys in order of their first appearance and the indexes of the records per key.
+func indexRecords(values reflect.Value, keyOf func(item interface{}) string) ([]string, map[string][]int) {
+	var keys []string
+	index := make(map[string][]int, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		key := keyOf(values.Index(i).Interface())
+		if _, ok := index[key]; !ok {
+			keys = append(keys, key)
+		}
+		index[key] = append(index[key], i)
+	}
+	return keys, index
+}
+
+func findDuplicates(keys []string, index map[string][]int) []duplicateKey {
+	var duplicates []duplicateKey
+	for _, key := range keys {
+		if len(index[key]) > 1 {
+			duplicates = append(duplicates, duplicateKey{
+				Key:     key,
+				Indexes: index[key],
+			})
+		}
+	}
+	return duplicates
+}
+
+// ambiguousMatches lists the duplicate keys of both sides with the fields which differ between the duplicates.
+func (result matchResult) ambiguousMatches(oldContent, newContent interface{}) []types.AmbiguousMatch {
+	var ambiguousMatches []types.AmbiguousMatch
+	for _, duplicate := range result.oldDuplicates {
+		ambiguousMatches = append(ambiguousMatches, duplicate.ambiguousMatch("old", oldContent))
+	}
+	for _, duplicate := range result.newDuplicates {
+		ambiguousMatches = append(ambiguousMatches, duplicate.ambiguousMatch("new", newContent))
+	}
+	return ambiguousMatches
+}
+
+func (duplicate duplicateKey) ambiguousMatch(installation string, content interface{}) types.AmbiguousMatch {
+	values := reflect.ValueOf(content)
+	ambiguousMatch := types.AmbiguousMatch{
+		QRadar:      installation,
+		Key:         duplicate.Key,
+		RecordCount: len(duplicate.Indexes),
+	}
+
+	var fieldNames []string
+	var records []map[string]string
+	for _, index := range duplicate.Indexes {
+		fields := make(map[string]string)
+		fieldNames = recordFields(values.Index(index), fields, nil)
+		records = append(records, fields)
+	}
+
+	for _, fieldName := range fieldNames {
+		var fieldValues []string
+		isDifferent := false
+		for _, record := range records {
+			fieldValues = append(fieldValues, record[fieldName])
+			if record[fieldName] != records[0][fieldName] {
+				isDifferent = true
+			}
+		}
+		if isDifferent {
+			ambiguousMatch.DifferentFields = append(ambiguousMatch.DifferentFields, types.AmbiguousField{
+				Name:   fieldName,
+				Values: fieldValues,
+			})
+		}
+	}
+	return ambiguousMatch
+}
+
+// recordFields flattens the exported fields of a record into fields, embedded structs are resolved into their fields.
+// It returns the field names in order of declaration.
+func recordFields(value reflect.Value, fields map[string]string, fieldNames []string) []string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return fieldNames
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		fields["Value"] = formatField(value)
+		return append(fieldNames, "Value")
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if field.Anonymous {
+			fieldNames = recordFields(value.Field(i), fields, fieldNames)
+			continue
+		}
+		fields[field.Name] = formatField(value.Field(i))
+		fieldNames = append(fieldNames, field.Name)
+	}
+	return fieldNames
+}
+
+func formatField(value reflect.Value) string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.String {
+		return value.String()
+	}
+	formatted, err := json.Marshal(value.Interface())
+	if err != nil {
+		return fmt.Sprintf("%v", value.Interface())
+	}
+	return string(formatted)
+}
+
+// matchKey joins the fields of a composite match key.
+func matchKey(fields ...string) string {
+	return strings.Join(fields, " | ")
+}
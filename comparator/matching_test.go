@@ -0,0 +1,290 @@
+package comparator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func stringKey(item interface{}) string {
+	return item.(string)
+}
+
+func TestMatchRecords(t *testing.T) {
+	tests := []struct {
+		name             string
+		oldContent       []string
+		newContent       []string
+		wantNewIndexes   []int
+		wantAddedIndexes []int
+	}{
+		{
+			name:             "equal",
+			oldContent:       []string{"a", "b"},
+			newContent:       []string{"a", "b"},
+			wantNewIndexes:   []int{0, 1},
+			wantAddedIndexes: nil,
+		},
+		{
+			name:             "reordered",
+			oldContent:       []string{"a", "b", "c"},
+			newContent:       []string{"c", "a", "b"},
+			wantNewIndexes:   []int{1, 2, 0},
+			wantAddedIndexes: nil,
+		},
+		{
+			name:             "missing",
+			oldContent:       []string{"a", "b", "c"},
+			newContent:       []string{"c"},
+			wantNewIndexes:   []int{-1, -1, 0},
+			wantAddedIndexes: nil,
+		},
+		{
+			name:             "added",
+			oldContent:       []string{"b"},
+			newContent:       []string{"a", "b", "c"},
+			wantNewIndexes:   []int{1},
+			wantAddedIndexes: []int{0, 2},
+		},
+		{
+			name:             "missing and added",
+			oldContent:       []string{"a", "b"},
+			newContent:       []string{"b", "c"},
+			wantNewIndexes:   []int{-1, 0},
+			wantAddedIndexes: []int{1},
+		},
+		{
+			name:             "empty old",
+			oldContent:       []string{},
+			newContent:       []string{"a"},
+			wantNewIndexes:   []int{},
+			wantAddedIndexes: []int{0},
+		},
+		{
+			name:             "empty new",
+			oldContent:       []string{"a"},
+			newContent:       []string{},
+			wantNewIndexes:   []int{-1},
+			wantAddedIndexes: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matchRecords(test.oldContent, test.newContent, stringKey)
+			if !reflect.DeepEqual(result.newIndexes, test.wantNewIndexes) {
+				t.Errorf("newIndexes = %v, want %v", result.newIndexes, test.wantNewIndexes)
+			}
+			if !reflect.DeepEqual(result.addedIndexes, test.wantAddedIndexes) {
+				t.Errorf("addedIndexes = %v, want %v", result.addedIndexes, test.wantAddedIndexes)
+			}
+		})
+	}
+}
+
+func TestMatchRecordsDuplicates(t *testing.T) {
+	tests := []struct {
+		name              string
+		oldContent        []string
+		newContent        []string
+		wantNewIndexes    []int
+		wantAddedIndexes  []int
+		wantOldDuplicates []duplicateKey
+		wantNewDuplicates []duplicateKey
+	}{
+		{
+			name:              "duplicates paired in order",
+			oldContent:        []string{"a", "b", "a"},
+			newContent:        []string{"a", "a", "b"},
+			wantNewIndexes:    []int{0, 2, 1},
+			wantAddedIndexes:  nil,
+			wantOldDuplicates: []duplicateKey{{Key: "a", Indexes: []int{0, 2}}},
+			wantNewDuplicates: []duplicateKey{{Key: "a", Indexes: []int{0, 1}}},
+		},
+		{
+			name:              "more duplicates in old",
+			oldContent:        []string{"a", "a", "a"},
+			newContent:        []string{"a"},
+			wantNewIndexes:    []int{0, -1, -1},
+			wantAddedIndexes:  nil,
+			wantOldDuplicates: []duplicateKey{{Key: "a", Indexes: []int{0, 1, 2}}},
+			wantNewDuplicates: nil,
+		},
+		{
+			name:              "more duplicates in new",
+			oldContent:        []string{"a"},
+			newContent:        []string{"b", "a", "a"},
+			wantNewIndexes:    []int{1},
+			wantAddedIndexes:  []int{0, 2},
+			wantOldDuplicates: nil,
+			wantNewDuplicates: []duplicateKey{{Key: "a", Indexes: []int{1, 2}}},
+		},
+		{
+			name:              "duplicates ordered by first appearance",
+			oldContent:        []string{"b", "a", "b", "a"},
+			newContent:        []string{"a", "b"},
+			wantNewIndexes:    []int{1, 0, -1, -1},
+			wantAddedIndexes:  nil,
+			wantOldDuplicates: []duplicateKey{{Key: "b", Indexes: []int{0, 2}}, {Key: "a", Indexes: []int{1, 3}}},
+			wantNewDuplicates: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matchRecords(test.oldContent, test.newContent, stringKey)
+			if !reflect.DeepEqual(result.newIndexes, test.wantNewIndexes) {
+				t.Errorf("newIndexes = %v, want %v", result.newIndexes, test.wantNewIndexes)
+			}
+			if !reflect.DeepEqual(result.addedIndexes, test.wantAddedIndexes) {
+				t.Errorf("addedIndexes = %v, want %v", result.addedIndexes, test.wantAddedIndexes)
+			}
+			if !reflect.DeepEqual(result.oldDuplicates, test.wantOldDuplicates) {
+				t.Errorf("oldDuplicates = %v, want %v", result.oldDuplicates, test.wantOldDuplicates)
+			}
+			if !reflect.DeepEqual(result.newDuplicates, test.wantNewDuplicates) {
+				t.Errorf("newDuplicates = %v, want %v", result.newDuplicates, test.wantNewDuplicates)
+			}
+		})
+	}
+}
+
+func TestTieBreakingKey(t *testing.T) {
+	type ownedRecord struct {
+		Title string
+		Owner string
+	}
+	titleKey := func(item interface{}) string {
+		return item.(ownedRecord).Title
+	}
+	ownerKey := func(item interface{}) string {
+		return item.(ownedRecord).Owner
+	}
+
+	tests := []struct {
+		name             string
+		oldContent       []ownedRecord
+		newContent       []ownedRecord
+		wantNewIndexes   []int
+		wantAddedIndexes []int
+	}{
+		{
+			name:             "unique title matches another owner",
+			oldContent:       []ownedRecord{{"Daily", "admin"}},
+			newContent:       []ownedRecord{{"Daily", "analyst"}},
+			wantNewIndexes:   []int{0},
+			wantAddedIndexes: nil,
+		},
+		{
+			name:             "duplicate titles matched by owner",
+			oldContent:       []ownedRecord{{"Daily", "admin"}, {"Daily", "analyst"}},
+			newContent:       []ownedRecord{{"Daily", "analyst"}, {"Daily", "admin"}},
+			wantNewIndexes:   []int{1, 0},
+			wantAddedIndexes: nil,
+		},
+		{
+			name:             "title duplicated in new only",
+			oldContent:       []ownedRecord{{"Daily", "admin"}},
+			newContent:       []ownedRecord{{"Daily", "analyst"}, {"Daily", "admin"}},
+			wantNewIndexes:   []int{1},
+			wantAddedIndexes: []int{0},
+		},
+		{
+			name:             "duplicate titles with changed owner",
+			oldContent:       []ownedRecord{{"Daily", "admin"}, {"Daily", "analyst"}},
+			newContent:       []ownedRecord{{"Daily", "admin"}, {"Daily", "auditor"}},
+			wantNewIndexes:   []int{0, -1},
+			wantAddedIndexes: []int{1},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keyOf := tieBreakingKey(test.oldContent, test.newContent, titleKey, ownerKey)
+			result := matchRecords(test.oldContent, test.newContent, keyOf)
+			if !reflect.DeepEqual(result.newIndexes, test.wantNewIndexes) {
+				t.Errorf("newIndexes = %v, want %v", result.newIndexes, test.wantNewIndexes)
+			}
+			if !reflect.DeepEqual(result.addedIndexes, test.wantAddedIndexes) {
+				t.Errorf("addedIndexes = %v, want %v", result.addedIndexes, test.wantAddedIndexes)
+			}
+		})
+	}
+}
+
+type embeddedRecord struct {
+	ID     *int    `json:"id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	TypeID *int    `json:"type_id,omitempty"`
+}
+
+type resolvedRecord struct {
+	embeddedRecord
+	Name         string
+	TypeName     string `json:"type_name"`
+	unexported   string
+	IgnoredField string `json:"-"`
+}
+
+func TestFindField(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     string
+		wantIndex []int
+		wantOk    bool
+	}{
+		{name: "struct name", field: "TypeName", wantIndex: []int{2}, wantOk: true},
+		{name: "json name", field: "type_name", wantIndex: []int{2}, wantOk: true},
+		{name: "ignoring case", field: "TYPENAME", wantIndex: []int{2}, wantOk: true},
+		{name: "embedded struct name", field: "TypeID", wantIndex: []int{0, 2}, wantOk: true},
+		{name: "embedded json name", field: "type_id", wantIndex: []int{0, 2}, wantOk: true},
+		{name: "outer field before embedded field", field: "name", wantIndex: []int{1}, wantOk: true},
+		{name: "embedded only", field: "id", wantIndex: []int{0, 0}, wantOk: true},
+		{name: "unexported", field: "unexported", wantIndex: nil, wantOk: false},
+		{name: "embedded struct itself", field: "embeddedRecord", wantIndex: nil, wantOk: false},
+		{name: "unknown", field: "Unknown", wantIndex: nil, wantOk: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			index, ok := findField(reflect.TypeOf(resolvedRecord{}), test.field)
+			if ok != test.wantOk || !reflect.DeepEqual(index, test.wantIndex) {
+				t.Errorf("findField(%q) = %v, %t, want %v, %t", test.field, index, ok, test.wantIndex, test.wantOk)
+			}
+		})
+	}
+}
+
+func TestFindFieldNoStruct(t *testing.T) {
+	if index, ok := findField(reflect.TypeOf(""), "Name"); ok {
+		t.Errorf("findField on a string = %v, true, want nil, false", index)
+	}
+}
+
+func TestFormatField(t *testing.T) {
+	var nilInterface interface{}
+	text := "text"
+	number := 42
+	enabled := true
+
+	tests := []struct {
+		name  string
+		value reflect.Value
+		want  string
+	}{
+		{name: "nil string pointer", value: reflect.ValueOf((*string)(nil)), want: ""},
+		{name: "nil int pointer", value: reflect.ValueOf((*int)(nil)), want: ""},
+		{name: "nil bool pointer", value: reflect.ValueOf((*bool)(nil)), want: ""},
+		{name: "nil struct pointer", value: reflect.ValueOf((*embeddedRecord)(nil)), want: ""},
+		{name: "nil interface", value: reflect.ValueOf(&nilInterface).Elem(), want: ""},
+		{name: "string pointer", value: reflect.ValueOf(&text), want: "text"},
+		{name: "int pointer", value: reflect.ValueOf(&number), want: "42"},
+		{name: "bool pointer", value: reflect.ValueOf(&enabled), want: "true"},
+		{name: "string", value: reflect.ValueOf("text"), want: "text"},
+		{name: "int slice", value: reflect.ValueOf([]int{1, 2}), want: "[1,2]"},
+		{name: "struct with nil fields", value: reflect.ValueOf(embeddedRecord{ID: &number}), want: `{"id":42}`},
+		{name: "nil field of a struct", value: reflect.ValueOf(embeddedRecord{}).Field(1), want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := formatField(test.value); got != test.want {
+				t.Errorf("formatField() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package comparator
+
+import (
+	"qradar-content-compare/config"
+	"qradar-content-compare/types"
+	"sort"
+	"strings"
+)
+
+// NormaliseDifferences normalises the values of all differences of the report by the normalisation rules of
+// the configuration. Differences which disappear by the normalisation are removed, records without any
+// remaining difference count as ok.
+func NormaliseDifferences(report types.Report) types.Report {
+	var differentRecords []types.DifferentRecord
+	for _, differentRecord := range report.DifferentRecords {
+		var differentElements []types.DifferentElement
+		for _, differentElement := range differentRecord.DifferentElements {
+			normalisationRule := configuration.Normalisation(report.ElementType, differentElement.Name)
+			oldValue := normaliseValue(differentElement.OldValue, normalisationRule)
+			newValue := normaliseValue(differentElement.NewValue, normalisationRule)
+			if oldValue == newValue {
+				continue
+			}
+			if oldValue != differentElement.OldValue || newValue != differentElement.NewValue {
+				differentElement.Normalised = true
+				differentElement.NormalisedOldValue = oldValue
+				differentElement.NormalisedNewValue = newValue
+			}
+			differentElements = append(differentElements, differentElement)
+		}
+
+		if len(differentElements) == 0 {
+			report.SameCount++
+			continue
+		}
+		differentRecord.DifferentElements = differentElements
+		differentRecords = append(differentRecords, differentRecord)
+	}
+	report.DifferentRecords = differentRecords
+
+	return report
+}
+
+func normaliseValue(value string, normalisationRule config.NormalisationRule) string {
+	if normalisationRule.LineEndings {
+		value = strings.Replace(value, "\r\n", "\n", -1)
+		value = strings.Replace(value, "\r", "\n", -1)
+	}
+	if normalisationRule.Trim {
+		value = strings.TrimSpace(value)
+	}
+	if normalisationRule.CaseFold {
+		value = strings.ToLower(value)
+	}
+	if normalisationRule.ListSeparator != "" && value != "" {
+		items := strings.Split(value, normalisationRule.ListSeparator)
+		for i := range items {
+			if normalisationRule.Trim {
+				items[i] = strings.TrimSpace(items[i])
+			}
+		}
+		sort.Strings(items)
+		value = strings.Join(items, normalisationRule.ListSeparator)
+	}
+	return value
+}
@@ -0,0 +1,124 @@
+package comparator
+
+import (
+	"io/ioutil"
+	"os"
+	"qradar-content-compare/config"
+	"qradar-content-compare/types"
+	"reflect"
+	"testing"
+)
+
+// loadConfig writes the configuration file content to a temporary file and loads it.
+func loadConfig(t *testing.T, content string) config.Config {
+	t.Helper()
+	file, err := ioutil.TempFile("", "qradar_compare_config_*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+	loadedConfig, err := config.Load(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loadedConfig
+}
+
+func TestNormaliseValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		rule  config.NormalisationRule
+		want  string
+	}{
+		{name: "no normalisation", value: " A\r\nb ", rule: config.NormalisationRule{}, want: " A\r\nb "},
+		{name: "trim", value: " \ttext\n ", rule: config.NormalisationRule{Trim: true}, want: "text"},
+		{name: "trim keeps inner whitespace", value: " a  b ", rule: config.NormalisationRule{Trim: true}, want: "a  b"},
+		{name: "case fold", value: "Rule Name", rule: config.NormalisationRule{CaseFold: true}, want: "rule name"},
+		{name: "windows line endings", value: "a\r\nb", rule: config.NormalisationRule{LineEndings: true}, want: "a\nb"},
+		{name: "mac line endings", value: "a\rb", rule: config.NormalisationRule{LineEndings: true}, want: "a\nb"},
+		{name: "line endings before trim", value: "a\r\n", rule: config.NormalisationRule{Trim: true, LineEndings: true}, want: "a"},
+		{name: "list sorted", value: "c, a, b", rule: config.NormalisationRule{ListSeparator: ", "}, want: "a, b, c"},
+		{name: "list items trimmed", value: " b ,a , c", rule: config.NormalisationRule{Trim: true, ListSeparator: ","}, want: "a,b,c"},
+		{name: "list items not trimmed without trim", value: "b ,a", rule: config.NormalisationRule{ListSeparator: ","}, want: "a,b "},
+		{name: "list sorted after case fold", value: "b, A", rule: config.NormalisationRule{CaseFold: true, ListSeparator: ", "}, want: "a, b"},
+		{name: "empty list", value: "", rule: config.NormalisationRule{Trim: true, ListSeparator: ", "}, want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := normaliseValue(test.value, test.rule); got != test.want {
+				t.Errorf("normaliseValue(%q) = %q, want %q", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNormalisation(t *testing.T) {
+	configured := loadConfig(t, `{"normalisation": [
+		{"report": "Rules", "field": "Description", "case_fold": true},
+		{"field": "Notes", "trim": true}]}`)
+
+	tests := []struct {
+		name        string
+		reportType  string
+		elementName string
+		value       string
+		want        string
+	}{
+		{name: "configured rule before default rule", reportType: "Rules", elementName: "Description", value: " Text ", want: " text "},
+		{name: "default rule for another report", reportType: "Log Sources", elementName: "Description", value: " Text ", want: "Text"},
+		{name: "configured rule for every report", reportType: "Log Sources", elementName: "Notes", value: " a\r\n", want: "a"},
+		{name: "default name list rule", reportType: "Reports", elementName: "Saved Search Names", value: "b, a ", want: "a, b"},
+		{name: "default rule keeps whitespace", reportType: "Rules", elementName: "Rule Test", value: " a\r\n", want: " a\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := configured.Normalisation(test.reportType, test.elementName)
+			if got := normaliseValue(test.value, rule); got != test.want {
+				t.Errorf("normalised %s %s = %q, want %q", test.reportType, test.elementName, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNormaliseDifferences(t *testing.T) {
+	defer func() {
+		configuration = config.Config{}
+	}()
+	configuration = config.Config{}
+
+	report := NormaliseDifferences(types.Report{
+		ElementType: "Rules",
+		SameCount:   1,
+		DifferentRecords: []types.DifferentRecord{
+			{RecordName: "Rule Name: A", DifferentElements: []types.DifferentElement{
+				{Name: "Description", OldValue: "text ", NewValue: "text"},
+			}},
+			{RecordName: "Rule Name: B", DifferentElements: []types.DifferentElement{
+				{Name: "Rule Test", OldValue: "a\r\nb", NewValue: "a\nb"},
+				{Name: "Description", OldValue: "old ", NewValue: "new"},
+				{Name: "Rule Test", OldValue: "a", NewValue: "b"},
+			}},
+		},
+	})
+
+	if report.SameCount != 2 {
+		t.Errorf("SameCount = %d, want 2", report.SameCount)
+	}
+	if len(report.DifferentRecords) != 1 {
+		t.Fatalf("DifferentRecords = %v, want only Rule Name: B", report.DifferentRecords)
+	}
+	want := []types.DifferentElement{
+		{Name: "Description", OldValue: "old ", NewValue: "new", Normalised: true, NormalisedOldValue: "old", NormalisedNewValue: "new"},
+		{Name: "Rule Test", OldValue: "a", NewValue: "b"},
+	}
+	if got := report.DifferentRecords[0].DifferentElements; !reflect.DeepEqual(got, want) {
+		t.Errorf("DifferentElements = %+v, want %+v", got, want)
+	}
+}
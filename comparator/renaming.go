@@ -0,0 +1,205 @@
+package comparator
+
+import (
+	"qradar-content-compare/types"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// renameMinNameSimilarity is the minimum similarity of the normalised keys for a pair to be considered.
+	renameMinNameSimilarity = 0.6
+	// renameMinConfidence is the minimum confidence for a pair to be reported as probably renamed.
+	renameMinConfidence = 0.75
+	// renameMaxComparisons limits the number of compared pairs, as every missing record is compared to every added record.
+	renameMaxComparisons = 250000
+)
+
+type renameCandidate struct {
+	oldIndex   int
+	newIndex   int
+	confidence float64
+}
+
+// matchRenamed pairs the records which are missing in the new QRadar with the added records by the similarity of
+// their normalised keys and their content. It is only used for reports keyed by a name, other keys like QIDs or
+// CIDRs are similar without being related, and not if the key of the report type is configured.
+// The paired records are treated as matched, so their differences are reported as usual and they are neither
+// missing nor added. The renames are listed by renamedRecords.
+func (result *matchResult) matchRenamed(reportType string) {
+	if _, ok := configuration.MatchKeys[reportType]; ok {
+		return
+	}
+
+	var missingIndexes []int
+	for oldIndex, newIndex := range result.newIndexes {
+		if newIndex < 0 {
+			missingIndexes = append(missingIndexes, oldIndex)
+		}
+	}
+	if len(missingIndexes) == 0 || len(result.addedIndexes) == 0 || len(missingIndexes)*len(result.addedIndexes) > renameMaxComparisons {
+		return
+	}
+
+	oldNames := make(map[int]string)
+	oldFields := make(map[int]map[string]string)
+	for _, oldIndex := range missingIndexes {
+		oldNames[oldIndex] = normaliseName(result.keyOf(result.oldValues.Index(oldIndex).Interface()))
+		oldFields[oldIndex] = make(map[string]string)
+		recordFields(result.oldValues.Index(oldIndex), oldFields[oldIndex], nil)
+	}
+	newNames := make(map[int]string)
+	newFields := make(map[int]map[string]string)
+	for _, newIndex := range result.addedIndexes {
+		newNames[newIndex] = normaliseName(result.keyOf(result.newValues.Index(newIndex).Interface()))
+		newFields[newIndex] = make(map[string]string)
+		recordFields(result.newValues.Index(newIndex), newFields[newIndex], nil)
+	}
+
+	var candidates []renameCandidate
+	for _, oldIndex := range missingIndexes {
+		for _, newIndex := range result.addedIndexes {
+			nameSimilarity := stringSimilarity(oldNames[oldIndex], newNames[newIndex])
+			if nameSimilarity < renameMinNameSimilarity {
+				continue
+			}
+			confidence := (nameSimilarity + contentSimilarity(oldFields[oldIndex], newFields[newIndex])) / 2
+			if confidence >= renameMinConfidence {
+				candidates = append(candidates, renameCandidate{
+					oldIndex:   oldIndex,
+					newIndex:   newIndex,
+					confidence: confidence,
+				})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].confidence > candidates[j].confidence
+	})
+
+	isPaired := make(map[int]bool)
+	result.renamed = make(map[int]types.RenamedRecord)
+	for _, candidate := range candidates {
+		if result.newIndexes[candidate.oldIndex] >= 0 || isPaired[candidate.newIndex] {
+			continue
+		}
+		result.newIndexes[candidate.oldIndex] = candidate.newIndex
+		isPaired[candidate.newIndex] = true
+		result.renamed[candidate.oldIndex] = types.RenamedRecord{
+			OldName:    result.keyOf(result.oldValues.Index(candidate.oldIndex).Interface()),
+			NewName:    result.keyOf(result.newValues.Index(candidate.newIndex).Interface()),
+			Confidence: candidate.confidence,
+		}
+	}
+
+	var addedIndexes []int
+	for _, newIndex := range result.addedIndexes {
+		if !isPaired[newIndex] {
+			addedIndexes = append(addedIndexes, newIndex)
+		}
+	}
+	result.addedIndexes = addedIndexes
+}
+
+// renamedRecords returns the records paired by matchRenamed in the order of the old records.
+func (result matchResult) renamedRecords() []types.RenamedRecord {
+	var oldIndexes []int
+	for oldIndex := range result.renamed {
+		oldIndexes = append(oldIndexes, oldIndex)
+	}
+	sort.Ints(oldIndexes)
+
+	var renamedRecords []types.RenamedRecord
+	for _, oldIndex := range oldIndexes {
+		renamedRecords = append(renamedRecords, result.renamed[oldIndex])
+	}
+	return renamedRecords
+}
+
+// normaliseName ignores case, surrounding and repeated whitespace and punctuation.
+func normaliseName(name string) string {
+	var normalised strings.Builder
+	isSpace := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if isSpace && normalised.Len() > 0 {
+				normalised.WriteRune(' ')
+			}
+			normalised.WriteRune(r)
+			isSpace = false
+		} else {
+			isSpace = true
+		}
+	}
+	return normalised.String()
+}
+
+// stringSimilarity returns 1 for equal strings and 0 for completely different strings. A string contained in
+// the other one, e.g. because the migration added a prefix, scores at least 0.8.
+func stringSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+
+	aRunes := []rune(a)
+	bRunes := []rune(b)
+	longest := len(aRunes)
+	if len(bRunes) > longest {
+		longest = len(bRunes)
+	}
+
+	if strings.Contains(a, b) || strings.Contains(b, a) {
+		shortest := len(aRunes) + len(bRunes) - longest
+		return 0.8 + 0.2*float64(shortest)/float64(longest)
+	}
+	return 1 - float64(levenshtein(aRunes, bRunes))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// contentSimilarity returns the share of equal fields of two records. IDs are ignored, they differ between installations.
+func contentSimilarity(oldFields, newFields map[string]string) float64 {
+	var fieldCount, equalCount int
+	for name, oldValue := range oldFields {
+		if name == "ID" || strings.HasSuffix(name, "ID") || strings.HasSuffix(name, "IDs") {
+			continue
+		}
+		fieldCount++
+		if newValue, ok := newFields[name]; ok && newValue == oldValue {
+			equalCount++
+		}
+	}
+	if fieldCount == 0 {
+		return 0
+	}
+	return float64(equalCount) / float64(fieldCount)
+}
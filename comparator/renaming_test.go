@@ -0,0 +1,110 @@
+package comparator
+
+import (
+	"math"
+	"qradar-content-compare/types"
+	"reflect"
+	"testing"
+)
+
+type namedRecord struct {
+	Name        string
+	Description string
+	Type        string
+}
+
+func namedRecordKey(item interface{}) string {
+	return item.(namedRecord).Name
+}
+
+func TestMatchRenamed(t *testing.T) {
+	tests := []struct {
+		name             string
+		reportType       string
+		oldContent       []namedRecord
+		newContent       []namedRecord
+		wantNewIndexes   []int
+		wantAddedIndexes []int
+		wantRenamed      []types.RenamedRecord
+	}{
+		{
+			name:             "prefixed name is paired",
+			oldContent:       []namedRecord{{"Failed Logins", "brute force", "EVENT"}},
+			newContent:       []namedRecord{{"MIG Failed Logins", "brute force", "EVENT"}},
+			wantNewIndexes:   []int{0},
+			wantAddedIndexes: nil,
+			wantRenamed:      []types.RenamedRecord{{OldName: "Failed Logins", NewName: "MIG Failed Logins", Confidence: (0.8 + 0.2*13/17.0 + 2/3.0) / 2}},
+		},
+		{
+			name:             "unrelated names are not paired",
+			oldContent:       []namedRecord{{"Failed Logins", "brute force", "EVENT"}},
+			newContent:       []namedRecord{{"Port Scan", "brute force", "EVENT"}},
+			wantNewIndexes:   []int{-1},
+			wantAddedIndexes: []int{0},
+			wantRenamed:      nil,
+		},
+		{
+			name:             "similar name with different content is not paired",
+			oldContent:       []namedRecord{{"Failed Logins", "brute force", "EVENT"}},
+			newContent:       []namedRecord{{"Failed Logins 2", "lockout", "FLOW"}},
+			wantNewIndexes:   []int{-1},
+			wantAddedIndexes: []int{0},
+			wantRenamed:      nil,
+		},
+		{
+			name:             "matched records are not paired",
+			oldContent:       []namedRecord{{"Failed Logins", "brute force", "EVENT"}},
+			newContent:       []namedRecord{{"Failed Logins", "brute force", "EVENT"}, {"Failed Logins 2", "brute force", "EVENT"}},
+			wantNewIndexes:   []int{0},
+			wantAddedIndexes: []int{1},
+			wantRenamed:      nil,
+		},
+		{
+			name:       "every added record is paired once",
+			oldContent: []namedRecord{{"Failed Logins", "brute force", "EVENT"}, {"Failed Login", "brute force", "EVENT"}},
+			newContent: []namedRecord{{"Failed Logins!", "brute force", "EVENT"}},
+			// the punctuation is ignored, so the first record is an exact match of the normalised name
+			wantNewIndexes:   []int{0, -1},
+			wantAddedIndexes: nil,
+			wantRenamed:      []types.RenamedRecord{{OldName: "Failed Logins", NewName: "Failed Logins!", Confidence: (1 + 2/3.0) / 2}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matches := matchRecords(test.oldContent, test.newContent, namedRecordKey)
+			matches.matchRenamed("Test Records")
+
+			if !reflect.DeepEqual(matches.newIndexes, test.wantNewIndexes) {
+				t.Errorf("newIndexes = %v, want %v", matches.newIndexes, test.wantNewIndexes)
+			}
+			if !reflect.DeepEqual(matches.addedIndexes, test.wantAddedIndexes) {
+				t.Errorf("addedIndexes = %v, want %v", matches.addedIndexes, test.wantAddedIndexes)
+			}
+			renamed := matches.renamedRecords()
+			if len(renamed) != len(test.wantRenamed) {
+				t.Fatalf("renamedRecords = %v, want %v", renamed, test.wantRenamed)
+			}
+			for i := range renamed {
+				if renamed[i].OldName != test.wantRenamed[i].OldName || renamed[i].NewName != test.wantRenamed[i].NewName ||
+					math.Abs(renamed[i].Confidence-test.wantRenamed[i].Confidence) > 1e-9 {
+					t.Errorf("renamedRecords[%d] = %v, want %v", i, renamed[i], test.wantRenamed[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMatchRenamedConfiguredKey(t *testing.T) {
+	configuration.MatchKeys = map[string][]string{"Test Records": {"Name"}}
+	defer func() {
+		configuration.MatchKeys = nil
+	}()
+
+	oldContent := []namedRecord{{"Failed Logins", "brute force", "EVENT"}}
+	newContent := []namedRecord{{"MIG Failed Logins", "brute force", "EVENT"}}
+	matches := matchRecords(oldContent, newContent, namedRecordKey)
+	matches.matchRenamed("Test Records")
+	if matches.newIndexes[0] != -1 || len(matches.renamed) != 0 {
+		t.Errorf("records of a report type with a configured key were paired: %v", matches.renamed)
+	}
+}
@@ -0,0 +1,58 @@
+package comparator
+
+import (
+	"qradar-content-compare/config"
+	"qradar-content-compare/types"
+)
+
+// ClassifySeverities classifies the summary lines, missing records, ambiguous matches, renamed records and every
+// difference of the report by the severity rules of the configuration and counts them per severity.
+func ClassifySeverities(report types.Report) types.Report {
+	report.SeverityCounts = make(map[string]int)
+	report.HighestSeverity = ""
+	count := func(severity string, occurrences int) {
+		report.SeverityCounts[severity] += occurrences
+		if types.SeverityRank(severity) > types.SeverityRank(report.HighestSeverity) {
+			report.HighestSeverity = severity
+		}
+	}
+
+	report.SummarySeverities = nil
+	for _, summaryLine := range report.Summary {
+		severity := configuration.Severity(report.ElementType, config.SummaryField, summaryLine)
+		report.SummarySeverities = append(report.SummarySeverities, severity)
+		count(severity, 1)
+	}
+
+	report.MissingSeverity = configuration.Severity(report.ElementType, config.MissingRecordField, "")
+	if len(report.MissingRecords) > 0 {
+		count(report.MissingSeverity, len(report.MissingRecords))
+	}
+
+	for i := range report.AmbiguousMatches {
+		severity := configuration.Severity(report.ElementType, config.AmbiguousMatchField, report.AmbiguousMatches[i].Key)
+		report.AmbiguousMatches[i].Severity = severity
+		count(severity, 1)
+	}
+
+	for i := range report.RenamedRecords {
+		severity := configuration.Severity(report.ElementType, config.RenamedRecordField, report.RenamedRecords[i].NewName)
+		report.RenamedRecords[i].Severity = severity
+		count(severity, 1)
+	}
+
+	for i := range report.DifferentRecords {
+		differentElements := report.DifferentRecords[i].DifferentElements
+		for j := range differentElements {
+			newValue := differentElements[j].NewValue
+			if differentElements[j].Normalised {
+				newValue = differentElements[j].NormalisedNewValue
+			}
+			severity := configuration.Severity(report.ElementType, differentElements[j].Name, newValue)
+			differentElements[j].Severity = severity
+			count(severity, 1)
+		}
+	}
+
+	return report
+}
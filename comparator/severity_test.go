@@ -0,0 +1,191 @@
+package comparator
+
+import (
+	"qradar-content-compare/config"
+	"qradar-content-compare/types"
+	"reflect"
+	"testing"
+)
+
+func TestSeverity(t *testing.T) {
+	configured := loadConfig(t, `{"severities": [
+		{"report": "Rules", "field": "Description", "severity": "critical"},
+		{"field": "Missing Record", "severity": "info"},
+		{"field": "Summary", "new_value": "older in new QRadar: *", "severity": "major"}]}`)
+
+	tests := []struct {
+		name        string
+		config      config.Config
+		reportType  string
+		elementName string
+		newValue    string
+		want        string
+	}{
+		{name: "missing rule", reportType: "Rules", elementName: config.MissingRecordField, want: types.SeverityCritical},
+		{name: "missing record", reportType: "Tenants", elementName: config.MissingRecordField, want: types.SeverityMajor},
+		{name: "summary with missing custom action", reportType: "Rules", elementName: config.SummaryField,
+			newValue: "rule A uses custom action B which does not exist in new QRadar", want: types.SeverityCritical},
+		{name: "summary with unavailable section", reportType: "System Configuration", elementName: config.SummaryField,
+			newValue: "section ariel is not available in the new QRadar", want: types.SeverityMajor},
+		{name: "summary with older version", reportType: "Extensions", elementName: config.SummaryField,
+			newValue: "older in new QRadar: App (1.2 < 1.3)", want: types.SeverityMinor},
+		{name: "other summary", reportType: "System Configuration", elementName: config.SummaryField,
+			newValue: "keys expected to differ (not compared): 3", want: types.SeverityInfo},
+		{name: "ambiguous match", reportType: "Rules", elementName: config.AmbiguousMatchField, newValue: "A", want: types.SeverityMajor},
+		{name: "renamed record", reportType: "Rules", elementName: config.RenamedRecordField, newValue: "B", want: types.SeverityMinor},
+		{name: "disabled rule", reportType: "Rules", elementName: "Rule Enabled", newValue: "false", want: types.SeverityCritical},
+		{name: "enabled rule", reportType: "Rules", elementName: "Rule Enabled", newValue: "true", want: types.SeverityMajor},
+		{name: "regex", reportType: "Custom Properties", elementName: "Property Regex", want: types.SeverityMajor},
+		{name: "description", reportType: "Rules", elementName: "Description", want: types.SeverityInfo},
+		{name: "any other field", reportType: "Rules", elementName: "Rule Test", want: types.SeverityMinor},
+		{name: "configured rule before default rule", config: configured, reportType: "Rules", elementName: "Description",
+			want: types.SeverityCritical},
+		{name: "configured rule for another report", config: configured, reportType: "Tenants", elementName: "Description",
+			want: types.SeverityInfo},
+		{name: "configured rule before specific default rule", config: configured, reportType: "Rules",
+			elementName: config.MissingRecordField, want: types.SeverityInfo},
+		{name: "configured summary rule", config: configured, reportType: "Extensions", elementName: config.SummaryField,
+			newValue: "older in new QRadar: App (1.2 < 1.3)", want: types.SeverityMajor},
+		{name: "default rule without matching configured rule", config: configured, reportType: "Rules",
+			elementName: "Rule Enabled", newValue: "false", want: types.SeverityCritical},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.config.Severity(test.reportType, test.elementName, test.newValue); got != test.want {
+				t.Errorf("Severity(%q, %q, %q) = %s, want %s", test.reportType, test.elementName, test.newValue, got, test.want)
+			}
+		})
+	}
+}
+
+func TestClassifySeverities(t *testing.T) {
+	defer func() {
+		configuration = config.Config{}
+	}()
+
+	tests := []struct {
+		name                  string
+		config                string
+		report                types.Report
+		wantSummarySeverities []string
+		wantMissingSeverity   string
+		wantCounts            map[string]int
+		wantHighest           string
+	}{
+		{
+			name:                "no differences",
+			config:              `{}`,
+			report:              types.Report{ElementType: "Rules", SameCount: 2},
+			wantMissingSeverity: types.SeverityCritical,
+			wantCounts:          map[string]int{},
+			wantHighest:         "",
+		},
+		{
+			name:   "summary lines only",
+			config: `{}`,
+			report: types.Report{
+				ElementType: "Extensions",
+				Summary:     []string{"older in new QRadar: App (1.2 < 1.3)", "compared extensions: 3"},
+			},
+			wantSummarySeverities: []string{types.SeverityMinor, types.SeverityInfo},
+			wantMissingSeverity:   types.SeverityMajor,
+			wantCounts:            map[string]int{types.SeverityMinor: 1, types.SeverityInfo: 1},
+			wantHighest:           types.SeverityMinor,
+		},
+		{
+			name:   "every part of the report",
+			config: `{}`,
+			report: types.Report{
+				ElementType:      "Rules",
+				Summary:          []string{"compared rules: 3"},
+				MissingRecords:   []string{"Rule Name: A", "Rule Name: B"},
+				AmbiguousMatches: []types.AmbiguousMatch{{QRadar: "new", Key: "C", RecordCount: 2}},
+				RenamedRecords:   []types.RenamedRecord{{OldName: "D", NewName: "E"}},
+				DifferentRecords: []types.DifferentRecord{{RecordName: "Rule Name: F", DifferentElements: []types.DifferentElement{
+					{Name: "Description", OldValue: "old", NewValue: "new"},
+					{Name: "Rule Test", OldValue: "a", NewValue: "b"},
+				}}},
+			},
+			wantSummarySeverities: []string{types.SeverityInfo},
+			wantMissingSeverity:   types.SeverityCritical,
+			wantCounts: map[string]int{types.SeverityCritical: 2, types.SeverityMajor: 1, types.SeverityMinor: 2,
+				types.SeverityInfo: 2},
+			wantHighest: types.SeverityCritical,
+		},
+		{
+			name:   "normalised new value",
+			config: `{"severities": [{"field": "Enabled", "new_value": "false", "severity": "critical"}]}`,
+			report: types.Report{
+				ElementType: "Log Sources",
+				DifferentRecords: []types.DifferentRecord{{RecordName: "Name: A", DifferentElements: []types.DifferentElement{
+					{Name: "Enabled", OldValue: "true", NewValue: " false", Normalised: true, NormalisedOldValue: "true",
+						NormalisedNewValue: "false"},
+				}}},
+			},
+			wantMissingSeverity: types.SeverityCritical,
+			wantCounts:          map[string]int{types.SeverityCritical: 1},
+			wantHighest:         types.SeverityCritical,
+		},
+		{
+			name:   "configured severity lowers the highest severity",
+			config: `{"severities": [{"report": "Rules", "field": "Missing Record", "severity": "info"}]}`,
+			report: types.Report{
+				ElementType:    "Rules",
+				MissingRecords: []string{"Rule Name: A"},
+			},
+			wantMissingSeverity: types.SeverityInfo,
+			wantCounts:          map[string]int{types.SeverityInfo: 1},
+			wantHighest:         types.SeverityInfo,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configuration = loadConfig(t, test.config)
+
+			report := ClassifySeverities(test.report)
+			if !reflect.DeepEqual(report.SummarySeverities, test.wantSummarySeverities) {
+				t.Errorf("SummarySeverities = %v, want %v", report.SummarySeverities, test.wantSummarySeverities)
+			}
+			if report.MissingSeverity != test.wantMissingSeverity {
+				t.Errorf("MissingSeverity = %s, want %s", report.MissingSeverity, test.wantMissingSeverity)
+			}
+			if !reflect.DeepEqual(report.SeverityCounts, test.wantCounts) {
+				t.Errorf("SeverityCounts = %v, want %v", report.SeverityCounts, test.wantCounts)
+			}
+			if report.HighestSeverity != test.wantHighest {
+				t.Errorf("HighestSeverity = %s, want %s", report.HighestSeverity, test.wantHighest)
+			}
+		})
+	}
+}
+
+func TestClassifySeveritiesOfEntries(t *testing.T) {
+	defer func() {
+		configuration = config.Config{}
+	}()
+	configuration = config.Config{}
+
+	report := ClassifySeverities(types.Report{
+		ElementType:      "Rules",
+		AmbiguousMatches: []types.AmbiguousMatch{{QRadar: "old", Key: "A", RecordCount: 2}},
+		RenamedRecords:   []types.RenamedRecord{{OldName: "B", NewName: "C"}},
+		DifferentRecords: []types.DifferentRecord{{RecordName: "Rule Name: D", DifferentElements: []types.DifferentElement{
+			{Name: "Rule Enabled", OldValue: "true", NewValue: "false"},
+			{Name: "Notes", OldValue: "a", NewValue: "b"},
+		}}},
+	})
+
+	if got := report.AmbiguousMatches[0].Severity; got != types.SeverityMajor {
+		t.Errorf("ambiguous match severity = %s, want %s", got, types.SeverityMajor)
+	}
+	if got := report.RenamedRecords[0].Severity; got != types.SeverityMinor {
+		t.Errorf("renamed record severity = %s, want %s", got, types.SeverityMinor)
+	}
+	var severities []string
+	for _, differentElement := range report.DifferentRecords[0].DifferentElements {
+		severities = append(severities, differentElement.Severity)
+	}
+	if want := []string{types.SeverityCritical, types.SeverityMinor}; !reflect.DeepEqual(severities, want) {
+		t.Errorf("element severities = %v, want %v", severities, want)
+	}
+}
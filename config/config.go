@@ -0,0 +1,244 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"qradar-content-compare/types"
+	"regexp"
+	"strings"
+)
+
+// DefaultFileName is the configuration file which is read from the working directory if no other file is given.
+const DefaultFileName = "qradar_compare_config.json"
+
+// Config adjusts how the reports are generated. All settings are optional.
+type Config struct {
+	// MatchKeys contains the fields the records are matched by per report type, e.g. "Log Sources": ["name", "type_id"].
+	// The fields are given by their api (json) or struct name. Both QID mapping reports are configured together
+	// by "QID Mappings".
+	MatchKeys map[string][]string `json:"match_keys"`
+	// NormalisationRules select how the values of the matching elements are normalised before they are compared.
+	// The first matching rule is used, elements without a matching rule use the defaultNormalisationRules.
+	NormalisationRules []NormalisationRule `json:"normalisation"`
+	// SeverityRules classify the differences. The first matching rule is used, differences without a matching
+	// rule are classified by the defaultSeverityRules.
+	SeverityRules []SeverityRule `json:"severities"`
+	// ExpectedDifferentSystemKeys are system configuration keys which differ between the installations in addition
+	// to the built-in ones (ids, hostnames, ips and dates). Keys are matched by their last part ignoring case.
+	ExpectedDifferentSystemKeys []string `json:"expected_different_system_keys"`
+	// IgnoreRules are read from the ignore file.
+	IgnoreRules []IgnoreRule `json:"-"`
+}
+
+// Field names severity and ignore rules use for the parts of a report which are not differences of an element.
+// Severity rules get a summary line, the key of an ambiguous match and the new name of a renamed record as new
+// value, ignore rules get the summary line, the key and the old name of a renamed record as record name.
+const (
+	MissingRecordField  = "Missing Record"
+	SummaryField        = "Summary"
+	AmbiguousMatchField = "Ambiguous Match"
+	RenamedRecordField  = "Renamed Record"
+)
+
+// SeverityRule classifies the differences matching the report, field and new value globs.
+type SeverityRule struct {
+	Report   string `json:"report"`
+	Field    string `json:"field"`
+	NewValue string `json:"new_value"`
+	Severity string `json:"severity"`
+
+	report   *regexp.Regexp
+	field    *regexp.Regexp
+	newValue *regexp.Regexp
+}
+
+// defaultSeverityRules flag lost content and disabled rules and log sources as critical and descriptive changes as info.
+var defaultSeverityRules = compileSeverityRules([]SeverityRule{
+	{Report: "Rules", Field: MissingRecordField, Severity: types.SeverityCritical},
+	{Report: "Log Sources", Field: MissingRecordField, Severity: types.SeverityCritical},
+	{Report: "Data Obfuscation Profiles", Field: MissingRecordField, Severity: types.SeverityCritical},
+	{Report: "Custom Actions", Field: MissingRecordField, Severity: types.SeverityCritical},
+	{Field: MissingRecordField, Severity: types.SeverityMajor},
+	{Field: SummaryField, NewValue: "rule * uses custom action * which does not exist in new QRadar", Severity: types.SeverityCritical},
+	{Field: SummaryField, NewValue: "rule * in new QRadar has a response with *", Severity: types.SeverityMajor},
+	{Field: SummaryField, NewValue: "not installed in new QRadar: *", Severity: types.SeverityMajor},
+	{Field: SummaryField, NewValue: "section * is not available in the new QRadar", Severity: types.SeverityMajor},
+	{Field: SummaryField, NewValue: "older in new QRadar: *", Severity: types.SeverityMinor},
+	{Field: SummaryField, NewValue: "changed from *", Severity: types.SeverityMinor},
+	{Field: SummaryField, Severity: types.SeverityInfo},
+	{Field: AmbiguousMatchField, Severity: types.SeverityMajor},
+	{Field: RenamedRecordField, Severity: types.SeverityMinor},
+	{Report: "Rules", Field: "Rule Enabled", NewValue: "false", Severity: types.SeverityCritical},
+	{Report: "Log Sources", Field: "Enabled", NewValue: "false", Severity: types.SeverityCritical},
+	{Field: "*Enabled", Severity: types.SeverityMajor},
+	{Field: "*Regex*", Severity: types.SeverityMajor},
+	{Field: "Has *", Severity: types.SeverityMajor},
+	{Field: "*Description*", Severity: types.SeverityInfo},
+	{Field: "*", Severity: types.SeverityMinor},
+})
+
+// Severity returns the severity of the difference of the element of the report type.
+func (config Config) Severity(reportType, elementName, newValue string) string {
+	for _, severityRules := range [][]SeverityRule{config.SeverityRules, defaultSeverityRules} {
+		for _, severityRule := range severityRules {
+			if severityRule.report.MatchString(reportType) && severityRule.field.MatchString(elementName) &&
+				severityRule.newValue.MatchString(newValue) {
+				return severityRule.Severity
+			}
+		}
+	}
+	return types.SeverityMinor
+}
+
+func compileSeverityRules(severityRules []SeverityRule) []SeverityRule {
+	for i := range severityRules {
+		severityRules[i].report = globToRegexp(severityRules[i].Report)
+		severityRules[i].field = globToRegexp(severityRules[i].Field)
+		severityRules[i].newValue = globToRegexp(severityRules[i].NewValue)
+	}
+	return severityRules
+}
+
+// NormalisationRule normalises the values of the elements matching the report and field globs.
+type NormalisationRule struct {
+	Report      string `json:"report"`
+	Field       string `json:"field"`
+	Trim        bool   `json:"trim"`
+	CaseFold    bool   `json:"case_fold"`
+	LineEndings bool   `json:"line_endings"`
+	// ListSeparator sorts the items of list values separated by it, e.g. ", ".
+	ListSeparator string `json:"list_separator"`
+
+	report *regexp.Regexp
+	field  *regexp.Regexp
+}
+
+// defaultNormalisationRules ignore line endings, surrounding whitespace of descriptions and the order of name lists.
+// Whitespace is kept for everything else, as it is significant in regexes and AQL.
+var defaultNormalisationRules = compileNormalisationRules([]NormalisationRule{
+	{Field: "*Names", Trim: true, LineEndings: true, ListSeparator: ", "},
+	{Field: "*Description*", Trim: true, LineEndings: true},
+	{Field: "*", LineEndings: true},
+})
+
+// Normalisation returns the normalisation rule for the element of the report type.
+func (config Config) Normalisation(reportType, elementName string) NormalisationRule {
+	for _, normalisationRules := range [][]NormalisationRule{config.NormalisationRules, defaultNormalisationRules} {
+		for _, normalisationRule := range normalisationRules {
+			if normalisationRule.report.MatchString(reportType) && normalisationRule.field.MatchString(elementName) {
+				return normalisationRule
+			}
+		}
+	}
+	return NormalisationRule{}
+}
+
+func compileNormalisationRules(normalisationRules []NormalisationRule) []NormalisationRule {
+	for i := range normalisationRules {
+		normalisationRules[i].report = globToRegexp(normalisationRules[i].Report)
+		normalisationRules[i].field = globToRegexp(normalisationRules[i].Field)
+	}
+	return normalisationRules
+}
+
+// Load reads the json configuration file.
+func Load(fileName string) (Config, error) {
+	var config Config
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return config, err
+	}
+	if err := json.Unmarshal(content, &config); err != nil {
+		return config, err
+	}
+	config.NormalisationRules = compileNormalisationRules(config.NormalisationRules)
+	for i, severityRule := range config.SeverityRules {
+		if types.SeverityRank(severityRule.Severity) == 0 {
+			return config, fmt.Errorf("severity rule %d: unknown severity %s", i+1, severityRule.Severity)
+		}
+	}
+	config.SeverityRules = compileSeverityRules(config.SeverityRules)
+	return config, nil
+}
+
+// DefaultIgnoreFileName is the ignore file which is read from the working directory if no other file is given.
+const DefaultIgnoreFileName = "qradar_compare_ignore.json"
+
+// IgnoreRule suppresses expected differences. The patterns are globs (* and ?) matched against the report type,
+// the record name and the element name as shown in the report, an empty pattern matches everything.
+// A rule with only a report pattern suppresses the whole report, a rule without a field pattern whole records.
+// Summary lines, ambiguous matches and renamed records are matched as records with the SummaryField,
+// AmbiguousMatchField and RenamedRecordField as element name.
+type IgnoreRule struct {
+	Report string `json:"report"`
+	Record string `json:"record"`
+	// RecordRegex is used instead of Record to match the record name by a regular expression.
+	RecordRegex string `json:"record_regex"`
+	Field       string `json:"field"`
+	// Comment documents why the difference is expected, it is not evaluated.
+	Comment string `json:"comment"`
+
+	report *regexp.Regexp
+	record *regexp.Regexp
+	field  *regexp.Regexp
+}
+
+// LoadIgnoreRules reads the json ignore file, which contains a list of IgnoreRules.
+func LoadIgnoreRules(fileName string) ([]IgnoreRule, error) {
+	var ignoreRules []IgnoreRule
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, &ignoreRules); err != nil {
+		return nil, err
+	}
+
+	for i := range ignoreRules {
+		ignoreRule := &ignoreRules[i]
+		if ignoreRule.Record != "" && ignoreRule.RecordRegex != "" {
+			return nil, fmt.Errorf("ignore rule %d: record and record_regex are exclusive", i+1)
+		}
+		// a rule without any pattern would suppress every report, e.g. an entry with only a comment
+		if ignoreRule.Report == "" && ignoreRule.Record == "" && ignoreRule.RecordRegex == "" && ignoreRule.Field == "" {
+			return nil, fmt.Errorf("ignore rule %d: at least one of report, record, record_regex and field is required", i+1)
+		}
+		ignoreRule.report = globToRegexp(ignoreRule.Report)
+		ignoreRule.record = globToRegexp(ignoreRule.Record)
+		if ignoreRule.RecordRegex != "" {
+			ignoreRule.record, err = regexp.Compile(ignoreRule.RecordRegex)
+			if err != nil {
+				return nil, fmt.Errorf("ignore rule %d: %v", i+1, err)
+			}
+		}
+		ignoreRule.field = globToRegexp(ignoreRule.Field)
+	}
+	return ignoreRules, nil
+}
+
+// SuppressesReport returns true if the rule suppresses the whole report, which requires a report pattern.
+func (ignoreRule IgnoreRule) SuppressesReport(reportType string) bool {
+	return ignoreRule.Report != "" && ignoreRule.Record == "" && ignoreRule.RecordRegex == "" && ignoreRule.Field == "" &&
+		ignoreRule.report.MatchString(reportType)
+}
+
+// SuppressesRecord returns true if the rule suppresses all differences of the record.
+func (ignoreRule IgnoreRule) SuppressesRecord(reportType, recordName string) bool {
+	return ignoreRule.Field == "" && ignoreRule.report.MatchString(reportType) && ignoreRule.record.MatchString(recordName)
+}
+
+// SuppressesElement returns true if the rule suppresses the difference of the element of the record.
+func (ignoreRule IgnoreRule) SuppressesElement(reportType, recordName, elementName string) bool {
+	return ignoreRule.report.MatchString(reportType) && ignoreRule.record.MatchString(recordName) && ignoreRule.field.MatchString(elementName)
+}
+
+func globToRegexp(glob string) *regexp.Regexp {
+	if glob == "" {
+		glob = "*"
+	}
+	pattern := regexp.QuoteMeta(glob)
+	pattern = strings.Replace(pattern, `\*`, ".*", -1)
+	pattern = strings.Replace(pattern, `\?`, ".", -1)
+	return regexp.MustCompile("(?s)^" + pattern + "$")
+}
@@ -0,0 +1,34 @@
+package config
+
+import "testing"
+
+func TestGlobToRegexp(t *testing.T) {
+	tests := []struct {
+		name  string
+		glob  string
+		value string
+		want  bool
+	}{
+		{name: "empty glob matches everything", glob: "", value: "Rule Enabled", want: true},
+		{name: "empty glob matches empty value", glob: "", value: "", want: true},
+		{name: "literal", glob: "Description", value: "Description", want: true},
+		{name: "literal is anchored at the start", glob: "Description", value: "Old Description", want: false},
+		{name: "literal is anchored at the end", glob: "Description", value: "Descriptions", want: false},
+		{name: "literal is case sensitive", glob: "Description", value: "description", want: false},
+		{name: "star prefix", glob: "*Enabled", value: "Rule Enabled", want: true},
+		{name: "star matches nothing", glob: "*Enabled", value: "Enabled", want: true},
+		{name: "star in the middle", glob: "rule * uses *", value: "rule A uses B", want: true},
+		{name: "star matches line breaks", glob: "Name: *", value: "Name: A\nB", want: true},
+		{name: "question mark matches one character", glob: "Name: ?", value: "Name: A", want: true},
+		{name: "question mark does not match two characters", glob: "Name: ?", value: "Name: AB", want: false},
+		{name: "regex characters are literal", glob: "Custom Properties (JSON)", value: "Custom Properties (JSON)", want: true},
+		{name: "dot is literal", glob: "7.2", value: "7x2", want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := globToRegexp(test.glob).MatchString(test.value); got != test.want {
+				t.Errorf("globToRegexp(%q).MatchString(%q) = %t, want %t", test.glob, test.value, got, test.want)
+			}
+		})
+	}
+}
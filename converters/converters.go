@@ -1,6 +1,9 @@
 package converters
 
-import "github.com/ilyaglow/go-qradar"
+import (
+	"github.com/ilyaglow/go-qradar"
+	"qradar-content-compare/types"
+)
 
 func LogSourceTypesToMap(itemList []qradar.LogSourceType) (map[int]string, error) {
 	resultMap := make(map[int]string)
@@ -81,3 +84,83 @@ func TenantsToMap(itemList []qradar.Tenant) (map[int]string, error) {
 	}
 	return resultMap, nil
 }
+
+func UserRolesToMap(itemList []types.UserRole) (map[int]string, error) {
+	resultMap := make(map[int]string)
+	for _, item := range itemList {
+		resultMap[*item.ID] = *item.Name
+	}
+	return resultMap, nil
+}
+
+func SecurityProfilesToMap(itemList []types.SecurityProfile) (map[int]string, error) {
+	resultMap := make(map[int]string)
+	for _, item := range itemList {
+		resultMap[*item.ID] = *item.Name
+	}
+	return resultMap, nil
+}
+
+func RegexPropertiesToMap(itemList []qradar.RegexProperty) (map[string]string, error) {
+	resultMap := make(map[string]string)
+	for _, item := range itemList {
+		resultMap[*item.Identifier] = *item.Name
+	}
+	return resultMap, nil
+}
+
+func RegexPropertyIDsToMap(itemList []qradar.RegexProperty) (map[int]string, error) {
+	resultMap := make(map[int]string)
+	for _, item := range itemList {
+		resultMap[*item.ID] = *item.Name
+	}
+	return resultMap, nil
+}
+
+func ForwardingDestinationsToMap(itemList []types.ForwardingDestination) (map[int]string, error) {
+	resultMap := make(map[int]string)
+	for _, item := range itemList {
+		resultMap[*item.ID] = *item.Name
+	}
+	return resultMap, nil
+}
+
+func DataObfuscationProfilesToMap(itemList []types.DataObfuscationProfile) (map[int]string, error) {
+	resultMap := make(map[int]string)
+	for _, item := range itemList {
+		resultMap[*item.ID] = *item.Name
+	}
+	return resultMap, nil
+}
+
+func SavedSearchesToMap(itemList []types.SavedSearch) (map[int]string, error) {
+	resultMap := make(map[int]string)
+	for _, item := range itemList {
+		resultMap[*item.ID] = *item.Name
+	}
+	return resultMap, nil
+}
+
+func ReportGroupsToMap(itemList []types.ReportGroup) (map[int]string, error) {
+	resultMap := make(map[int]string)
+	for _, item := range itemList {
+		resultMap[*item.ID] = *item.Name
+	}
+	return resultMap, nil
+}
+
+func VulnerabilityScannersToMap(itemList []types.VulnerabilityScanner) (map[int]string, error) {
+	resultMap := make(map[int]string)
+	for _, item := range itemList {
+		resultMap[*item.ID] = *item.Name
+	}
+	return resultMap, nil
+}
+
+func CustomActionInterpretersToMap(itemList []types.CustomActionInterpreter) (map[int]string, error) {
+	resultMap := make(map[int]string)
+	for _, item := range itemList {
+		resultMap[*item.ID] = *item.Name
+	}
+	return resultMap, nil
+}
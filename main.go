@@ -1,13 +1,17 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"github.com/ilyaglow/go-qradar"
 	"log"
+	"os"
 	"qradar-content-compare/comparator"
+	"qradar-content-compare/config"
 	"qradar-content-compare/questions"
 	"qradar-content-compare/reporting"
 	"qradar-content-compare/types"
+	"sort"
 	"sync"
 )
 
@@ -15,10 +19,50 @@ var Version = ""
 
 var reportTypes = []string{"Tenants", "Domains", "Log Sources",
 	"Log Source Groups", "Rules", "Rule Groups",
-	"Network Hierarchy", "DSM Mappings", "QIDs", "Custom Properties"}
+	"Network Hierarchy", "DSM Mappings", "QIDs", "Custom Properties", "Custom Properties (JSON)", "Custom Properties (LEEF)",
+	"Custom Properties (CEF)", "Custom Properties (NVP)", "Custom Properties (XML)",
+	"Custom Properties (Calculated)", "Custom Properties (AQL)",
+	"Custom Property Definitions", "Authorized Services",
+	"Extensions", "Apps", "Forwarding Destinations", "Routing Rules",
+	"System Configuration", "Event Retention Buckets", "Flow Retention Buckets",
+	"Data Obfuscation Profiles", "Data Obfuscation Expressions",
+	"Historical Correlation Profiles", "Reports", "Dashboards",
+	"Asset Properties", "Vulnerability Scanners", "Scan Profiles",
+	"Custom Actions"}
+
+// optInReportTypes are not part of a full report because they take a long time on large installations.
+var optInReportTypes = []string{"DSM Mappings (All)", "Assets (Sample)"}
+
+// exitCodes are returned for the highest severity found, errors exit with 1 and info or no differences with 0.
+var exitCodes = map[string]int{
+	types.SeverityMinor:    2,
+	types.SeverityMajor:    3,
+	types.SeverityCritical: 4,
+}
+
+// generatedReports collects the reports of all report types for the severity summary.
+var generatedReports []types.Report
+var generatedReportsMutex sync.Mutex
+
+var configFileName = flag.String("config", config.DefaultFileName, "json configuration file")
+var ignoreFileName = flag.String("ignore", config.DefaultIgnoreFileName, "json file with the expected differences")
 
 func main() {
+	flag.Parse()
 	fmt.Println("Welcome to QRadar Content Compare (Version " + Version + ")")
+
+	// the default configuration file is optional
+	configuration, err := config.Load(*configFileName)
+	if err != nil && !(os.IsNotExist(err) && *configFileName == config.DefaultFileName) {
+		log.Fatal(err)
+	}
+	// the default ignore file is optional as well
+	configuration.IgnoreRules, err = config.LoadIgnoreRules(*ignoreFileName)
+	if err != nil && !(os.IsNotExist(err) && *ignoreFileName == config.DefaultIgnoreFileName) {
+		log.Fatal(err)
+	}
+	comparator.SetConfiguration(configuration)
+
 	loop()
 }
 
@@ -48,7 +92,7 @@ func loop(){
 
 	answers := reportTypes
 	if !fullReport{
-		answers, err = questions.AskForReportSelection(reportTypes)
+		answers, err = questions.AskForReportSelection(append(reportTypes, optInReportTypes...))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -63,6 +107,27 @@ func loop(){
 
 	wg.Wait()
 	fmt.Println("all reports are generated")
+
+	sort.Slice(generatedReports, func(i, j int) bool {
+		return generatedReports[i].ElementType < generatedReports[j].ElementType
+	})
+	reporting.SeveritySummaryToTerminal(generatedReports)
+	if err := reporting.SeveritySummaryToFile(generatedReports); err != nil {
+		log.Fatal(err)
+	}
+
+	os.Exit(exitCode(generatedReports))
+}
+
+// exitCode returns the exit code for the highest severity of all reports.
+func exitCode(reports []types.Report) int {
+	highestSeverity := ""
+	for _, report := range reports {
+		if types.SeverityRank(report.HighestSeverity) > types.SeverityRank(highestSeverity) {
+			highestSeverity = report.HighestSeverity
+		}
+	}
+	return exitCodes[highestSeverity]
 }
 
 func generateReport(oldQradar *qradar.Client, newQradar *qradar.Client, reportType string, wg *sync.WaitGroup) {
@@ -127,13 +192,20 @@ func generateReport(oldQradar *qradar.Client, newQradar *qradar.Client, reportTy
 			log.Fatal(err)
 		}
 		reports = append(reports, dsmMappingReport)
+	case "DSM Mappings (All)":
+		fmt.Println("compare all dsm mappings...")
+		allDsmMappingReport, err := comparator.CompareAllDSMMappings(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, allDsmMappingReport)
 	case "QIDs":
 		fmt.Println("compare qids...")
-		qidReport, err := comparator.CompareQidMappings(oldQradar, newQradar)
+		userCreatedQidReport, systemQidReport, err := comparator.CompareQidMappings(oldQradar, newQradar)
 		if err != nil {
 			log.Fatal(err)
 		}
-		reports = append(reports, qidReport)
+		reports = append(reports, userCreatedQidReport, systemQidReport)
 	case "Custom Properties":
 		fmt.Println("compare custom properties...")
 		customPropertyReport, err := comparator.CompareCustomProperties(oldQradar, newQradar)
@@ -141,11 +213,207 @@ func generateReport(oldQradar *qradar.Client, newQradar *qradar.Client, reportTy
 			log.Fatal(err)
 		}
 		reports = append(reports, customPropertyReport)
+	case "Custom Properties (JSON)":
+		fmt.Println("compare custom properties (JSON)...")
+		customPropertyJSONReport, err := comparator.CompareCustomPropertiesJSON(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, customPropertyJSONReport)
+	case "Custom Properties (LEEF)":
+		fmt.Println("compare custom properties (LEEF)...")
+		customPropertyLEEFReport, err := comparator.CompareCustomPropertiesLEEF(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, customPropertyLEEFReport)
+	case "Custom Properties (CEF)":
+		fmt.Println("compare custom properties (CEF)...")
+		customPropertyCEFReport, err := comparator.CompareCustomPropertiesCEF(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, customPropertyCEFReport)
+	case "Custom Properties (NVP)":
+		fmt.Println("compare custom properties (NVP)...")
+		customPropertyNVPReport, err := comparator.CompareCustomPropertiesNVP(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, customPropertyNVPReport)
+	case "Custom Properties (XML)":
+		fmt.Println("compare custom properties (XML)...")
+		customPropertyXMLReport, err := comparator.CompareCustomPropertiesXML(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, customPropertyXMLReport)
+	case "Custom Properties (Calculated)":
+		fmt.Println("compare custom properties (calculated)...")
+		calculatedPropertyReport, err := comparator.CompareCalculatedProperties(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, calculatedPropertyReport)
+	case "Custom Properties (AQL)":
+		fmt.Println("compare custom properties (aql)...")
+		aqlPropertyReport, err := comparator.CompareAQLProperties(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, aqlPropertyReport)
+	case "Custom Property Definitions":
+		fmt.Println("compare custom property definitions...")
+		regexPropertyReport, err := comparator.CompareRegexProperties(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, regexPropertyReport)
+	case "Authorized Services":
+		fmt.Println("compare authorized services...")
+		authorizedServiceReport, err := comparator.CompareAuthorizedServices(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, authorizedServiceReport)
+	case "Extensions":
+		fmt.Println("compare extensions...")
+		extensionReport, err := comparator.CompareExtensions(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, extensionReport)
+	case "Apps":
+		fmt.Println("compare apps...")
+		applicationReport, err := comparator.CompareApplications(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, applicationReport)
+	case "Forwarding Destinations":
+		fmt.Println("compare forwarding destinations...")
+		forwardingDestinationReport, err := comparator.CompareForwardingDestinations(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, forwardingDestinationReport)
+	case "Routing Rules":
+		fmt.Println("compare routing rules...")
+		routingRuleReport, err := comparator.CompareRoutingRules(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, routingRuleReport)
+	case "System Configuration":
+		fmt.Println("compare system configuration...")
+		systemConfigurationReport, err := comparator.CompareSystemConfiguration(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, systemConfigurationReport)
+	case "Event Retention Buckets":
+		fmt.Println("compare event retention buckets...")
+		eventRetentionBucketReport, err := comparator.CompareEventRetentionBuckets(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, eventRetentionBucketReport)
+	case "Flow Retention Buckets":
+		fmt.Println("compare flow retention buckets...")
+		flowRetentionBucketReport, err := comparator.CompareFlowRetentionBuckets(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, flowRetentionBucketReport)
+	case "Data Obfuscation Profiles":
+		fmt.Println("compare data obfuscation profiles...")
+		dataObfuscationProfileReport, err := comparator.CompareDataObfuscationProfiles(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, dataObfuscationProfileReport)
+	case "Data Obfuscation Expressions":
+		fmt.Println("compare data obfuscation expressions...")
+		dataObfuscationExpressionReport, err := comparator.CompareDataObfuscationExpressions(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, dataObfuscationExpressionReport)
+	case "Historical Correlation Profiles":
+		fmt.Println("compare historical correlation profiles...")
+		historicalCorrelationProfileReport, err := comparator.CompareHistoricalCorrelationProfiles(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, historicalCorrelationProfileReport)
+	case "Reports":
+		fmt.Println("compare reports...")
+		reportTemplateReport, err := comparator.CompareReportTemplates(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, reportTemplateReport)
+	case "Dashboards":
+		fmt.Println("compare dashboards...")
+		dashboardReport, err := comparator.CompareDashboards(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, dashboardReport)
+	case "Asset Properties":
+		fmt.Println("compare asset properties...")
+		assetPropertyReport, err := comparator.CompareAssetProperties(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, assetPropertyReport)
+	case "Assets (Sample)":
+		fmt.Println("compare asset sample...")
+		assetReport, err := comparator.CompareAssetsSample(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, assetReport)
+	case "Vulnerability Scanners":
+		fmt.Println("compare vulnerability scanners...")
+		vulnerabilityScannerReport, err := comparator.CompareVulnerabilityScanners(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, vulnerabilityScannerReport)
+	case "Scan Profiles":
+		fmt.Println("compare scan profiles...")
+		scanProfileReport, err := comparator.CompareScanProfiles(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, scanProfileReport)
+	case "Custom Actions":
+		fmt.Println("compare custom actions...")
+		customActionReport, err := comparator.CompareCustomActions(oldQradar, newQradar)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reports = append(reports, customActionReport)
 	default:
 		log.Fatal("report type not implemented yet")
 	}
 
-	err := reporting.ReportToFiles(reports)
+	var filteredReports []types.Report
+	for _, report := range reports {
+		report, ok := comparator.ApplyIgnoreRules(comparator.NormaliseDifferences(report))
+		if !ok {
+			fmt.Println("report for " + report.ElementType + " is suppressed by the ignore file")
+			continue
+		}
+		filteredReports = append(filteredReports, comparator.ClassifySeverities(report))
+	}
+
+	generatedReportsMutex.Lock()
+	generatedReports = append(generatedReports, filteredReports...)
+	generatedReportsMutex.Unlock()
+
+	err := reporting.ReportToFiles(filteredReports)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"qradar-content-compare/types"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name       string
+		severities []string
+		want       int
+	}{
+		{name: "no reports", severities: nil, want: 0},
+		{name: "no differences", severities: []string{""}, want: 0},
+		{name: "info", severities: []string{types.SeverityInfo, ""}, want: 0},
+		{name: "minor", severities: []string{types.SeverityInfo, types.SeverityMinor}, want: 2},
+		{name: "major", severities: []string{types.SeverityMajor, types.SeverityMinor}, want: 3},
+		{name: "critical", severities: []string{types.SeverityMinor, types.SeverityCritical, types.SeverityMajor}, want: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reports []types.Report
+			for _, severity := range test.severities {
+				reports = append(reports, types.Report{HighestSeverity: severity})
+			}
+			if got := exitCode(reports); got != test.want {
+				t.Errorf("exitCode(%v) = %d, want %d", test.severities, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package qradarenhanced
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/ilyaglow/go-qradar"
+	"net/http"
+)
+
+// getAPI queries QRadar endpoints which are not covered by go-qradar and decodes the json result into result.
+func getAPI(qRadar *qradar.Client, apiPath, fields, filter string, result interface{}) error {
+	return getAPIRange(qRadar, apiPath, fields, filter, 0, 0, result)
+}
+
+// getAPIRange works like getAPI but only requests the items from-to (inclusive) if to is set.
+func getAPIRange(qRadar *qradar.Client, apiPath, fields, filter string, from, to int, result interface{}) error {
+	req, err := newAPIRequest(qRadar, apiPath, fields, filter, from, to)
+	if err != nil {
+		return err
+	}
+
+	_, err = qRadar.Do(context.Background(), req, result)
+	return err
+}
+
+// getAPIIfAvailable works like getAPI but returns false instead of an error if the endpoint does not exist
+// in the api version of the installation. All other errors, e.g. missing permissions, are returned.
+func getAPIIfAvailable(qRadar *qradar.Client, apiPath, fields, filter string, result interface{}) (bool, error) {
+	req, err := newAPIRequest(qRadar, apiPath, fields, filter, 0, 0)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := qRadar.Do(context.Background(), req, result)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func newAPIRequest(qRadar *qradar.Client, apiPath, fields, filter string, from, to int) (*http.Request, error) {
+	req, err := qRadar.NewRequest(http.MethodGet, apiPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	if to > 0 {
+		req.Header.Add("Range", fmt.Sprintf("items=%d-%d", from, to))
+	}
+
+	q := req.URL.Query()
+	if fields != "" {
+		q.Add("fields", fields)
+	}
+	if filter != "" {
+		q.Add("filter", filter)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	return req, nil
+}
+
+// getAPIRaw downloads the raw response body of an endpoint, e.g. the content of a custom action script.
+func getAPIRaw(qRadar *qradar.Client, apiPath string) ([]byte, error) {
+	req, err := qRadar.NewRequest(http.MethodGet, apiPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+
+	var result bytes.Buffer
+	_, err = qRadar.Do(context.Background(), req, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
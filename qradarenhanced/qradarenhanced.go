@@ -1,13 +1,19 @@
 package qradarenhanced
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"encoding/xml"
+	"fmt"
 	"github.com/ilyaglow/go-qradar"
 	"qradar-content-compare/converters"
 	"qradar-content-compare/types"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 )
 
 func GetPropertiesRegexExpressionResolved(qRadar *qradar.Client) ([]types.PropertyExpressionRegexResolved, error) {
@@ -62,6 +68,214 @@ func GetPropertiesRegexExpressionResolved(qRadar *qradar.Client) ([]types.Proper
 	return propertiesResolved, nil
 }
 
+const (
+	PropertyExpressionJSON = "JSON"
+	PropertyExpressionLEEF = "LEEF"
+	PropertyExpressionCEF  = "CEF"
+	PropertyExpressionNVP  = "NVP"
+	PropertyExpressionXML  = "XML"
+)
+
+func GetPropertyExpressionsResolved(qRadar *qradar.Client, expressionType string) ([]types.PropertyExpressionResolved, error) {
+	var propertyExpressions []qradar.PropertyExpression
+	var err error
+	switch expressionType {
+	case PropertyExpressionJSON:
+		propertyExpressions, err = qRadar.PropertyJSONExpression.Get(context.Background(), "", "", 0, 0)
+	case PropertyExpressionLEEF:
+		propertyExpressions, err = qRadar.PropertyLEEFExpression.Get(context.Background(), "", "", 0, 0)
+	case PropertyExpressionCEF:
+		propertyExpressions, err = qRadar.PropertyCEFExpression.Get(context.Background(), "", "", 0, 0)
+	case PropertyExpressionNVP:
+		propertyExpressions, err = qRadar.ProperetyNVPExpression.Get(context.Background(), "", "", 0, 0)
+	case PropertyExpressionXML:
+		err = getAPI(qRadar, "api/config/event_sources/custom_properties/property_xml_expressions", "", "", &propertyExpressions)
+	default:
+		return nil, fmt.Errorf("property expression type %s not supported", expressionType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	regexProperties, err := getRegexPropertiesMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	logSourceTypes, err := getLogSourcesTypeMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	logSources, err := getLogSourcesMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	lowLevelCategories, err := getLogLowLevelCategoryMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	qids, err := getQIDsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var propertyExpressionsResolved []types.PropertyExpressionResolved
+	for _, propertyExpression := range propertyExpressions {
+		propertyExpressionResolved := types.PropertyExpressionResolved{
+			PropertyExpression: propertyExpression,
+			ExpressionType:     expressionType,
+		}
+
+		if propertyExpression.RegexPropertyIdentifier != nil {
+			propertyExpressionResolved.PropertyName = regexProperties[*propertyExpression.RegexPropertyIdentifier]
+		}
+		if propertyExpression.LogSourceTypeID != nil {
+			propertyExpressionResolved.LogSourceTypeName = logSourceTypes[*propertyExpression.LogSourceTypeID]
+		}
+		if propertyExpression.LogSourceID != nil {
+			propertyExpressionResolved.LogSourceName = logSources[*propertyExpression.LogSourceID]
+		}
+		if propertyExpression.QID != nil {
+			propertyExpressionResolved.QidName = qids[*propertyExpression.QID]
+		}
+		if propertyExpression.LowLevelCategoryID != nil {
+			propertyExpressionResolved.LowLevelCategoryName = lowLevelCategories[*propertyExpression.LowLevelCategoryID]
+		}
+
+		propertyExpressionsResolved = append(propertyExpressionsResolved, propertyExpressionResolved)
+	}
+
+	return propertyExpressionsResolved, nil
+}
+
+func GetRegexPropertiesResolved(qRadar *qradar.Client) ([]types.RegexPropertyResolved, error) {
+	regexProperties, err := qRadar.RegexProperty.Get(context.Background(), "", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	propertyExpressions, err := GetPropertiesRegexExpressionResolved(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	expressionsByProperty := make(map[string][]types.PropertyExpressionRegexResolved)
+	for _, propertyExpression := range propertyExpressions {
+		if propertyExpression.RegexPropertyIdentifier != nil {
+			expressionsByProperty[*propertyExpression.RegexPropertyIdentifier] = append(expressionsByProperty[*propertyExpression.RegexPropertyIdentifier], propertyExpression)
+		}
+	}
+
+	var regexPropertiesResolved []types.RegexPropertyResolved
+	for _, regexProperty := range regexProperties {
+		regexPropertyResolved := types.RegexPropertyResolved{
+			RegexProperty: regexProperty,
+		}
+
+		if regexProperty.Identifier != nil {
+			regexPropertyResolved.Expressions = expressionsByProperty[*regexProperty.Identifier]
+		}
+
+		regexPropertiesResolved = append(regexPropertiesResolved, regexPropertyResolved)
+	}
+
+	return regexPropertiesResolved, nil
+}
+
+var calculatedPropertyOperators = map[string]string{
+	"ADD":      "+",
+	"SUBTRACT": "-",
+	"MULTIPLY": "*",
+	"DIVIDE":   "/",
+}
+
+func GetCalculatedPropertiesResolved(qRadar *qradar.Client) ([]types.CalculatedPropertyResolved, error) {
+	var calculatedProperties []types.CalculatedProperty
+	err := getAPI(qRadar, "api/config/event_sources/custom_properties/calculated_properties", "", "", &calculatedProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	regexProperties, err := getRegexPropertyIDsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	// calculated properties can reference other calculated properties, which have their own ids
+	calculatedPropertyNames := make(map[int]string)
+	for _, calculatedProperty := range calculatedProperties {
+		calculatedPropertyNames[*calculatedProperty.ID] = *calculatedProperty.Name
+	}
+
+	var calculatedPropertiesResolved []types.CalculatedPropertyResolved
+	for _, calculatedProperty := range calculatedProperties {
+		calculatedPropertyResolved := types.CalculatedPropertyResolved{
+			CalculatedProperty: calculatedProperty,
+			OperandOneName:     calculatedPropertyOperandName(calculatedProperty.OperandOne, regexProperties, calculatedPropertyNames),
+			OperandTwoName:     calculatedPropertyOperandName(calculatedProperty.OperandTwo, regexProperties, calculatedPropertyNames),
+		}
+
+		operator := ""
+		if calculatedProperty.Operator != nil {
+			operator = calculatedPropertyOperators[*calculatedProperty.Operator]
+			if operator == "" {
+				operator = *calculatedProperty.Operator
+			}
+		}
+		calculatedPropertyResolved.Expression = calculatedPropertyResolved.OperandOneName + " " + operator + " " + calculatedPropertyResolved.OperandTwoName
+
+		calculatedPropertiesResolved = append(calculatedPropertiesResolved, calculatedPropertyResolved)
+	}
+
+	return calculatedPropertiesResolved, nil
+}
+
+// Operand types of calculated properties, the id of an operand refers to a regex or a calculated property.
+const (
+	calculatedPropertyOperandProperty           = "PROPERTY"
+	calculatedPropertyOperandCalculatedProperty = "CALCULATED_PROPERTY"
+)
+
+func calculatedPropertyOperandName(operand types.CalculatedPropertyOperand, regexPropertyNames, calculatedPropertyNames map[int]string) string {
+	operandType := ""
+	if operand.Type != nil {
+		operandType = strings.ToUpper(*operand.Type)
+	}
+
+	var propertyNames map[int]string
+	switch operandType {
+	case calculatedPropertyOperandProperty:
+		propertyNames = regexPropertyNames
+	case calculatedPropertyOperandCalculatedProperty:
+		propertyNames = calculatedPropertyNames
+	default:
+		if operand.Value != nil {
+			return strconv.FormatFloat(*operand.Value, 'f', -1, 64)
+		}
+	}
+
+	if operand.ID != nil {
+		if name, ok := propertyNames[*operand.ID]; ok {
+			return name
+		}
+		return "unknown " + strings.ToLower(operandType) + " " + strconv.Itoa(*operand.ID)
+	}
+	return ""
+}
+
+func GetAQLProperties(qRadar *qradar.Client) ([]types.AQLProperty, error) {
+	var aqlProperties []types.AQLProperty
+	err := getAPI(qRadar, "api/config/event_sources/custom_properties/aql_properties", "", "", &aqlProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	return aqlProperties, nil
+}
+
 func GetRuleGroupsResolved(qRadar *qradar.Client) ([]types.RuleGroupResolved, error) {
 	ruleGroups, err := qRadar.RuleGroup.Get(context.Background(), "", "", 0, 0)
 	if err != nil {
@@ -145,7 +359,8 @@ func GetNetworkHierarchyResolved(qRadar *qradar.Client) ([]types.NetworkHierarch
 	return networkHierarchiesResolved, nil
 }
 
-func GetQIDsResolved(qRadar *qradar.Client) (map[string]types.QIDsResolved, error) {
+// GetQIDsResolved returns the QIDs sorted by their key.
+func GetQIDsResolved(qRadar *qradar.Client) ([]types.QIDsResolved, error) {
 	qids, err := qRadar.QID.Get(context.Background(), "", "", 0, 0)
 	if err != nil {
 		return nil, err
@@ -161,7 +376,7 @@ func GetQIDsResolved(qRadar *qradar.Client) (map[string]types.QIDsResolved, erro
 		return nil, err
 	}
 
-	qIDsResolved := make(map[string]types.QIDsResolved)
+	var qIDsResolved []types.QIDsResolved
 
 	for _, qid := range qids {
 		qidResolved := types.QIDsResolved{
@@ -175,18 +390,27 @@ func GetQIDsResolved(qRadar *qradar.Client) (map[string]types.QIDsResolved, erro
 		}
 		qidResolved.LogSourceTypeName = logSourceTypeName
 
-		if qid.LogSourceTypeID != nil {
-			qidResolved.LowLevelCategoryName = lowLevelCategories[*qid.LowLevelCategoryID]
-		}
-
-		qIDsResolved[*qid.Name+strconv.Itoa(*qid.QID)] = qidResolved
+		qIDsResolved = append(qIDsResolved, qidResolved)
 	}
+	sort.SliceStable(qIDsResolved, func(i, j int) bool {
+		return qIDsResolved[i].Key().String() < qIDsResolved[j].Key().String()
+	})
 
 	return qIDsResolved, nil
 }
 
-func GetDSMMappingsResolved(qRadar *qradar.Client) (map[string]types.DsmResolved, error) {
-	dsms, err := qRadar.DSM.Get(context.Background(), "", "custom_event=true", 0, 0)
+// dsmMappingsPageSize is the number of mappings requested at once when all mappings are loaded.
+const dsmMappingsPageSize = 10000
+
+// GetDSMMappingsResolved returns the custom DSM mappings, or all mappings if allMappings is set, sorted by their key.
+func GetDSMMappingsResolved(qRadar *qradar.Client, allMappings bool) ([]types.DsmResolved, error) {
+	var dsms []qradar.DSM
+	var err error
+	if allMappings {
+		dsms, err = getAllDSMMappings(qRadar)
+	} else {
+		dsms, err = qRadar.DSM.Get(context.Background(), "", "custom_event=true", 0, 0)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -196,12 +420,17 @@ func GetDSMMappingsResolved(qRadar *qradar.Client) (map[string]types.DsmResolved
 		return nil, err
 	}
 
-	qids, err := getQIDsMinimum(qRadar)
+	qids, err := getQIDsByID(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	lowLevelCategories, err := getLogLowLevelCategoryMinimum(qRadar)
 	if err != nil {
 		return nil, err
 	}
 
-	dsmsResolved := make(map[string]types.DsmResolved)
+	var dsmsResolved []types.DsmResolved
 
 	for _, dsm := range dsms {
 		dsmResolved := types.DsmResolved{
@@ -213,26 +442,42 @@ func GetDSMMappingsResolved(qRadar *qradar.Client) (map[string]types.DsmResolved
 		}
 
 		if dsm.QIDRecordID != nil {
-			dsmResolved.QidName = qids[*dsm.QIDRecordID]
-		}
-
-		eventCategory := ""
-		if dsm.LogSourceEventCategory != nil {
-			eventCategory = *dsm.LogSourceEventCategory
-		}
-
-		logSourceEventID := ""
-		if dsm.LogSourceEventID != nil {
-			eventCategory = *dsm.LogSourceEventID
+			if qid, ok := qids[*dsm.QIDRecordID]; ok {
+				dsmResolved.QidName = *qid.Name
+				if qid.Severity != nil {
+					dsmResolved.QidSeverity = *qid.Severity
+				}
+				if qid.LowLevelCategoryID != nil {
+					dsmResolved.LowLevelCategoryName = lowLevelCategories[*qid.LowLevelCategoryID]
+				}
+			}
 		}
-		searchString := dsmResolved.LogSourceTypeName + dsmResolved.QidName + eventCategory + logSourceEventID
-		dsmsResolved[searchString] = dsmResolved
 
+		dsmsResolved = append(dsmsResolved, dsmResolved)
 	}
+	sort.SliceStable(dsmsResolved, func(i, j int) bool {
+		return dsmsResolved[i].Key().String() < dsmsResolved[j].Key().String()
+	})
 
 	return dsmsResolved, nil
 }
 
+// getAllDSMMappings pages through all mappings, as the vendor shipped mappings are too many for a single request.
+func getAllDSMMappings(qRadar *qradar.Client) ([]qradar.DSM, error) {
+	var dsms []qradar.DSM
+	for from := 0; ; from += dsmMappingsPageSize {
+		var page []qradar.DSM
+		err := getAPIRange(qRadar, "api/data_classification/dsm_event_mappings", "id,log_source_type_id,log_source_event_id,log_source_event_category,custom_event,qid_record_id", "", from, from+dsmMappingsPageSize-1, &page)
+		if err != nil {
+			return nil, err
+		}
+		dsms = append(dsms, page...)
+		if len(page) < dsmMappingsPageSize {
+			return dsms, nil
+		}
+	}
+}
+
 func GetRulesResolved(qRadar *qradar.Client) ([]types.RulesWithDataResolved, error) {
 	rules, err := qRadar.RuleWithData.Get(context.Background(), "", "", 0, 0)
 	if err != nil {
@@ -373,83 +618,956 @@ func GetDomainsResolved(qRadar *qradar.Client) ([]types.DomainResolved, error) {
 }
 
 
-func getTenantsMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.Tenant.Get(context.Background(), "", "deleted=false", 0, 0)
+func GetAuthorizedServicesResolved(qRadar *qradar.Client) ([]types.AuthorizedServiceResolved, error) {
+	var authorizedServices []types.AuthorizedService
+	err := getAPI(qRadar, "api/config/access/authorized_services", "id,label,role_id,security_profile_id,tenant_id,expiry_date,created_by,creation_date", "", &authorizedServices)
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.TenantsToMap(resultItems)
-}
-func getLogSourceGroupsMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.LogSourceGroup.Get(context.Background(), "name,id", "", 0, 0)
+	userRoles, err := getUserRolesMinimum(qRadar)
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.LogSourceGroupsToMap(resultItems)
-}
-func getLogSourceExtensionsMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.LogSourceExtension.Get(context.Background(), "name,id", "", 0, 0)
+	securityProfiles, err := getSecurityProfilesMinimum(qRadar)
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.LogSourceExtensionsToMap(resultItems)
+	tenants, err := getTenantsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var authorizedServicesResolved []types.AuthorizedServiceResolved
+	for _, authorizedService := range authorizedServices {
+		authorizedServiceResolved := types.AuthorizedServiceResolved{
+			AuthorizedService: authorizedService,
+			Expiry:            "No Expiry",
+		}
+
+		if authorizedService.RoleID != nil {
+			authorizedServiceResolved.RoleName = userRoles[*authorizedService.RoleID]
+		}
+		if authorizedService.SecurityProfileID != nil {
+			authorizedServiceResolved.SecurityProfileName = securityProfiles[*authorizedService.SecurityProfileID]
+		}
+		if authorizedService.TenantID != nil {
+			authorizedServiceResolved.TenantName = tenants[*authorizedService.TenantID]
+		}
+		if authorizedService.ExpiryDate != nil && *authorizedService.ExpiryDate > 0 {
+			authorizedServiceResolved.Expiry = time.Unix(*authorizedService.ExpiryDate/1000, 0).UTC().Format("2006-01-02")
+		}
+
+		authorizedServicesResolved = append(authorizedServicesResolved, authorizedServiceResolved)
+	}
+
+	return authorizedServicesResolved, nil
 }
-func getDomainsMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.Domain.Get(context.Background(), "name,id", "", 0, 0)
+
+
+func GetExtensions(qRadar *qradar.Client) ([]types.Extension, error) {
+	var extensions []types.Extension
+	err := getAPI(qRadar, "api/config/extension_management/extensions", "", "", &extensions)
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.DomainsToMap(resultItems)
+	return extensions, nil
 }
-func getLogSourcesMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.LogSource.Get(context.Background(), "name,id", "", 0, 0)
+
+func GetApplications(qRadar *qradar.Client) ([]types.Application, error) {
+	var applications []types.Application
+	err := getAPI(qRadar, "api/gui_app_framework/applications", "", "", &applications)
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.LogSourcesToMap(resultItems)
+	return applications, nil
 }
-func getLogSourcesTypeMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.LogSourceType.Get(context.Background(), "id,name", "", 0, 0)
+
+func GetForwardingDestinations(qRadar *qradar.Client) ([]types.ForwardingDestination, error) {
+	var forwardingDestinations []types.ForwardingDestination
+	err := getAPI(qRadar, "api/config/event_forwarding/forwarding_destinations", "", "", &forwardingDestinations)
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.LogSourceTypesToMap(resultItems)
+	return forwardingDestinations, nil
 }
-func getLogLowLevelCategoryMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.LowLevelCategory.Get(context.Background(), "id,name", "", 0, 0)
+
+func GetRoutingRulesResolved(qRadar *qradar.Client) ([]types.RoutingRuleResolved, error) {
+	var routingRules []types.RoutingRule
+	err := getAPI(qRadar, "api/config/event_forwarding/routing_rules", "", "", &routingRules)
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.LowLevelCategoriesToMap(resultItems)
+	forwardingDestinations, err := getForwardingDestinationsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	logSources, err := getLogSourcesMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	logSourceGroups, err := getLogSourceGroupsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	logSourceTypes, err := getLogSourcesTypeMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	// filter values of these types are ids and have to be resolved to be comparable
+	filterValueNames := map[string]map[int]string{
+		"LOG_SOURCE":       logSources,
+		"LOG_SOURCE_GROUP": logSourceGroups,
+		"LOG_SOURCE_TYPE":  logSourceTypes,
+	}
+
+	var routingRulesResolved []types.RoutingRuleResolved
+	for _, routingRule := range routingRules {
+		routingRuleResolved := types.RoutingRuleResolved{
+			RoutingRule: routingRule,
+		}
+
+		for _, filter := range routingRule.Filters {
+			filterType, operator, value := "", "", ""
+			if filter.Type != nil {
+				filterType = *filter.Type
+			}
+			if filter.Operator != nil {
+				operator = *filter.Operator
+			}
+			if filter.Value != nil {
+				value = *filter.Value
+				if names, ok := filterValueNames[filterType]; ok {
+					if id, err := strconv.Atoi(value); err == nil && names[id] != "" {
+						value = names[id]
+					}
+				}
+			}
+			routingRuleResolved.FilterNames = append(routingRuleResolved.FilterNames, filterType+" "+operator+" "+value)
+		}
+		sort.Strings(routingRuleResolved.FilterNames)
+
+		for _, destinationID := range routingRule.DestinationIDs {
+			routingRuleResolved.DestinationNames = append(routingRuleResolved.DestinationNames, forwardingDestinations[destinationID])
+		}
+		sort.Strings(routingRuleResolved.DestinationNames)
+
+		routingRulesResolved = append(routingRulesResolved, routingRuleResolved)
+	}
+
+	return routingRulesResolved, nil
 }
-func getQIDsMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.QID.Get(context.Background(), "id,name", "", 0, 0)
+
+const (
+	RetentionBucketsEvents = "events"
+	RetentionBucketsFlows  = "flows"
+)
+
+func GetRetentionBucketsResolved(qRadar *qradar.Client, database string) ([]types.RetentionBucketResolved, error) {
+	var retentionBuckets []types.RetentionBucket
+	var err error
+	switch database {
+	case RetentionBucketsEvents:
+		err = getAPI(qRadar, "api/config/event_retention_buckets", "", "", &retentionBuckets)
+	case RetentionBucketsFlows:
+		err = getAPI(qRadar, "api/config/flow_retention_buckets", "", "", &retentionBuckets)
+	default:
+		return nil, fmt.Errorf("retention bucket database %s not supported", database)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.QIDsToMap(resultItems)
+	tenants, err := getTenantsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := getDomainsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var retentionBucketsResolved []types.RetentionBucketResolved
+	for _, retentionBucket := range retentionBuckets {
+		retentionBucketResolved := types.RetentionBucketResolved{
+			RetentionBucket: retentionBucket,
+		}
+
+		if retentionBucket.TenantID != nil {
+			retentionBucketResolved.TenantName = tenants[*retentionBucket.TenantID]
+		}
+		if retentionBucket.DomainID != nil {
+			if *retentionBucket.DomainID == 0 {
+				retentionBucketResolved.DomainName = "Default Domain"
+			} else {
+				retentionBucketResolved.DomainName = domains[*retentionBucket.DomainID]
+			}
+		}
+
+		retentionBucketsResolved = append(retentionBucketsResolved, retentionBucketResolved)
+	}
+
+	return retentionBucketsResolved, nil
 }
-func getRulesMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.Rule.Get(context.Background(), "id,name", "", 0, 0)
+
+func GetDataObfuscationProfilesResolved(qRadar *qradar.Client) ([]types.DataObfuscationProfileResolved, error) {
+	var dataObfuscationProfiles []types.DataObfuscationProfile
+	err := getAPI(qRadar, "api/config/data_obfuscation/profiles", "", "", &dataObfuscationProfiles)
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.RulesToMap(resultItems)
+	domains, err := getDomainsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataObfuscationProfilesResolved []types.DataObfuscationProfileResolved
+	for _, dataObfuscationProfile := range dataObfuscationProfiles {
+		dataObfuscationProfileResolved := types.DataObfuscationProfileResolved{
+			DataObfuscationProfile: dataObfuscationProfile,
+		}
+
+		for _, domainID := range dataObfuscationProfile.DomainIDs {
+			if domainID == 0 {
+				dataObfuscationProfileResolved.DomainNames = append(dataObfuscationProfileResolved.DomainNames, "Default Domain")
+			} else {
+				dataObfuscationProfileResolved.DomainNames = append(dataObfuscationProfileResolved.DomainNames, domains[domainID])
+			}
+		}
+		sort.Strings(dataObfuscationProfileResolved.DomainNames)
+
+		dataObfuscationProfilesResolved = append(dataObfuscationProfilesResolved, dataObfuscationProfileResolved)
+	}
+
+	return dataObfuscationProfilesResolved, nil
 }
-func getBuildingBlocksMinimum(qRadar *qradar.Client) (map[int]string, error) {
-	resultItems, err := qRadar.BuildingBlock.Get(context.Background(), "id,name", "", 0, 0)
+
+func GetDataObfuscationExpressionsResolved(qRadar *qradar.Client) ([]types.DataObfuscationExpressionResolved, error) {
+	var dataObfuscationExpressions []types.DataObfuscationExpression
+	err := getAPI(qRadar, "api/config/data_obfuscation/expressions", "", "", &dataObfuscationExpressions)
 	if err != nil {
 		return nil, err
 	}
 
-	return converters.BuildingBlocksToMap(resultItems)
+	dataObfuscationProfiles, err := getDataObfuscationProfilesMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataObfuscationExpressionsResolved []types.DataObfuscationExpressionResolved
+	for _, dataObfuscationExpression := range dataObfuscationExpressions {
+		dataObfuscationExpressionResolved := types.DataObfuscationExpressionResolved{
+			DataObfuscationExpression: dataObfuscationExpression,
+		}
+
+		if dataObfuscationExpression.ProfileID != nil {
+			dataObfuscationExpressionResolved.ProfileName = dataObfuscationProfiles[*dataObfuscationExpression.ProfileID]
+		}
+
+		dataObfuscationExpressionsResolved = append(dataObfuscationExpressionsResolved, dataObfuscationExpressionResolved)
+	}
+
+	return dataObfuscationExpressionsResolved, nil
+}
+
+func GetHistoricalCorrelationProfilesResolved(qRadar *qradar.Client) ([]types.HistoricalCorrelationProfileResolved, error) {
+	var historicalCorrelationProfiles []types.HistoricalCorrelationProfile
+	err := getAPI(qRadar, "api/analytics/historical_correlation/profiles", "", "", &historicalCorrelationProfiles)
+	if err != nil {
+		return nil, err
+	}
+
+	savedSearches, err := getSavedSearchesMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := getRulesMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	buildingBlocks, err := getBuildingBlocksMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var historicalCorrelationProfilesResolved []types.HistoricalCorrelationProfileResolved
+	for _, historicalCorrelationProfile := range historicalCorrelationProfiles {
+		historicalCorrelationProfileResolved := types.HistoricalCorrelationProfileResolved{
+			HistoricalCorrelationProfile: historicalCorrelationProfile,
+		}
+
+		if historicalCorrelationProfile.SavedSearchID != nil {
+			historicalCorrelationProfileResolved.SavedSearchName = savedSearches[*historicalCorrelationProfile.SavedSearchID]
+		}
+
+		for _, ruleID := range historicalCorrelationProfile.RuleIDs {
+			var name = rules[ruleID]
+			if name == "" {
+				name = buildingBlocks[ruleID]
+			}
+			if name != "" {
+				historicalCorrelationProfileResolved.RuleNames = append(historicalCorrelationProfileResolved.RuleNames, name)
+			}
+		}
+		sort.Strings(historicalCorrelationProfileResolved.RuleNames)
+
+		schedule := historicalCorrelationProfile.Schedule
+		if schedule.Recurrence != nil {
+			historicalCorrelationProfileResolved.ScheduleSummary = *schedule.Recurrence
+			if schedule.Interval != nil {
+				historicalCorrelationProfileResolved.ScheduleSummary += " every " + strconv.Itoa(*schedule.Interval)
+			}
+			if schedule.StartTime != nil {
+				historicalCorrelationProfileResolved.ScheduleSummary += " at " + time.Unix(*schedule.StartTime/1000, 0).UTC().Format("15:04") + " UTC"
+			}
+		}
+
+		historicalCorrelationProfilesResolved = append(historicalCorrelationProfilesResolved, historicalCorrelationProfileResolved)
+	}
+
+	return historicalCorrelationProfilesResolved, nil
+}
+
+func GetReportTemplatesResolved(qRadar *qradar.Client) ([]types.ReportTemplateResolved, error) {
+	var reportTemplates []types.ReportTemplate
+	err := getAPI(qRadar, "api/config/reports/report_templates", "", "", &reportTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	reportGroups, err := getReportGroupsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	savedSearches, err := getSavedSearchesMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var reportTemplatesResolved []types.ReportTemplateResolved
+	for _, reportTemplate := range reportTemplates {
+		reportTemplateResolved := types.ReportTemplateResolved{
+			ReportTemplate:  reportTemplate,
+			ScheduleSummary: "Manual",
+		}
+
+		// ids which do not resolve, e.g. deleted saved searches, are kept visible instead of comparing as ""
+		for _, groupID := range reportTemplate.GroupIDs {
+			groupName, ok := reportGroups[groupID]
+			if !ok {
+				groupName = "unknown report group " + strconv.Itoa(groupID)
+			}
+			reportTemplateResolved.GroupNames = append(reportTemplateResolved.GroupNames, groupName)
+		}
+		sort.Strings(reportTemplateResolved.GroupNames)
+
+		for _, savedSearchID := range reportTemplate.SavedSearchIDs {
+			savedSearchName, ok := savedSearches[savedSearchID]
+			if !ok {
+				savedSearchName = "unknown saved search " + strconv.Itoa(savedSearchID)
+			}
+			reportTemplateResolved.SavedSearchNames = append(reportTemplateResolved.SavedSearchNames, savedSearchName)
+		}
+		sort.Strings(reportTemplateResolved.SavedSearchNames)
+
+		schedule := reportTemplate.Schedule
+		if schedule.Frequency != nil {
+			reportTemplateResolved.ScheduleSummary = *schedule.Frequency
+			if schedule.Day != nil {
+				reportTemplateResolved.ScheduleSummary += " on " + *schedule.Day
+			}
+			if schedule.Hour != nil {
+				reportTemplateResolved.ScheduleSummary += " at " + strconv.Itoa(*schedule.Hour) + ":00"
+			}
+		}
+
+		reportTemplatesResolved = append(reportTemplatesResolved, reportTemplateResolved)
+	}
+
+	return reportTemplatesResolved, nil
+}
+
+func GetDashboardsResolved(qRadar *qradar.Client) ([]types.DashboardResolved, error) {
+	var dashboards []types.Dashboard
+	err := getAPI(qRadar, "api/config/dashboards", "", "", &dashboards)
+	if err != nil {
+		return nil, err
+	}
+
+	savedSearches, err := getSavedSearchesMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var dashboardsResolved []types.DashboardResolved
+	for _, dashboard := range dashboards {
+		dashboardResolved := types.DashboardResolved{
+			Dashboard: dashboard,
+		}
+
+		for _, item := range dashboard.Items {
+			itemResolved := types.DashboardItemResolved{
+				DashboardItem: item,
+			}
+			if item.SavedSearchID != nil {
+				savedSearchName, ok := savedSearches[*item.SavedSearchID]
+				if !ok {
+					savedSearchName = "unknown saved search " + strconv.Itoa(*item.SavedSearchID)
+				}
+				itemResolved.SavedSearchName = savedSearchName
+			}
+			dashboardResolved.ItemsResolved = append(dashboardResolved.ItemsResolved, itemResolved)
+		}
+
+		dashboardsResolved = append(dashboardsResolved, dashboardResolved)
+	}
+
+	sort.Slice(dashboardsResolved, func(i, j int) bool {
+		iOwner, jOwner := stringValue(dashboardsResolved[i].Owner), stringValue(dashboardsResolved[j].Owner)
+		if iOwner != jOwner {
+			return iOwner < jOwner
+		}
+		return stringValue(dashboardsResolved[i].Name) < stringValue(dashboardsResolved[j].Name)
+	})
+
+	return dashboardsResolved, nil
+}
+
+func GetCustomAssetProperties(qRadar *qradar.Client) ([]types.AssetProperty, error) {
+	var assetProperties []types.AssetProperty
+	err := getAPI(qRadar, "api/asset_model/properties", "", "custom=true", &assetProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	return assetProperties, nil
+}
+
+// assetFields are the fields requested for the asset comparison.
+const assetFields = "id,domain_id,interfaces,hostnames,properties"
+
+// GetAssetsResolved returns the first sampleSize assets.
+func GetAssetsResolved(qRadar *qradar.Client, sampleSize int) ([]types.AssetResolved, error) {
+	var assets []types.Asset
+	err := getAPIRange(qRadar, "api/asset_model/assets", assetFields, "", 0, sampleSize-1, &assets)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveAssets(qRadar, assets)
+}
+
+// assetFilterBatchSize is the number of addresses looked up by a single request, to keep the url short.
+const assetFilterBatchSize = 25
+
+// GetAssetsResolvedByAddress returns the assets with one of the ip addresses or hostnames, so a sample can be
+// looked up without downloading all assets.
+func GetAssetsResolvedByAddress(qRadar *qradar.Client, ipAddresses, hostnames []string) ([]types.AssetResolved, error) {
+	var filters []string
+	for _, ipAddress := range ipAddresses {
+		filters = append(filters, fmt.Sprintf("interfaces contains (ip_addresses contains (value = %s))", strconv.Quote(ipAddress)))
+	}
+	for _, hostname := range hostnames {
+		filters = append(filters, fmt.Sprintf("hostnames contains (name = %s)", strconv.Quote(hostname)))
+	}
+
+	var assets []types.Asset
+	for from := 0; from < len(filters); from += assetFilterBatchSize {
+		to := from + assetFilterBatchSize
+		if to > len(filters) {
+			to = len(filters)
+		}
+
+		var batch []types.Asset
+		err := getAPI(qRadar, "api/asset_model/assets", assetFields, strings.Join(filters[from:to], " or "), &batch)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, batch...)
+	}
+
+	return resolveAssets(qRadar, assets)
+}
+
+func resolveAssets(qRadar *qradar.Client, assets []types.Asset) ([]types.AssetResolved, error) {
+	domains, err := getDomainsMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var assetsResolved []types.AssetResolved
+	for _, asset := range assets {
+		assetResolved := types.AssetResolved{
+			Asset: asset,
+		}
+
+		if asset.DomainID != nil {
+			if *asset.DomainID == 0 {
+				assetResolved.DomainName = "Default Domain"
+			} else {
+				assetResolved.DomainName = domains[*asset.DomainID]
+			}
+		}
+		for _, assetInterface := range asset.Interfaces {
+			for _, ipAddress := range assetInterface.IPAddresses {
+				if ipAddress.Value != nil {
+					assetResolved.IPAddresses = append(assetResolved.IPAddresses, *ipAddress.Value)
+				}
+			}
+		}
+		sort.Strings(assetResolved.IPAddresses)
+		for _, hostname := range asset.Hostnames {
+			if hostname.Name != nil {
+				assetResolved.Hostnames = append(assetResolved.Hostnames, *hostname.Name)
+			}
+		}
+		sort.Strings(assetResolved.Hostnames)
+		for _, property := range asset.Properties {
+			if property.Name != nil && property.Value != nil {
+				assetResolved.Properties = append(assetResolved.Properties, *property.Name+": "+*property.Value)
+			}
+		}
+		sort.Strings(assetResolved.Properties)
+
+		assetsResolved = append(assetsResolved, assetResolved)
+	}
+
+	return assetsResolved, nil
+}
+
+const maskedCredentials = "********"
+
+func GetVulnerabilityScannersResolved(qRadar *qradar.Client) ([]types.VulnerabilityScannerResolved, error) {
+	var vulnerabilityScanners []types.VulnerabilityScanner
+	// the password is left out of the requested fields, so it is never transferred
+	err := getAPI(qRadar, "api/config/vulnerability_scanners", "id,name,description,scanner_type,host,cidr_ranges,username,schedule", "", &vulnerabilityScanners)
+	if err != nil {
+		return nil, err
+	}
+
+	var vulnerabilityScannersResolved []types.VulnerabilityScannerResolved
+	for _, vulnerabilityScanner := range vulnerabilityScanners {
+		vulnerabilityScannerResolved := types.VulnerabilityScannerResolved{
+			VulnerabilityScanner: vulnerabilityScanner,
+			ScheduleSummary:      scanScheduleSummary(vulnerabilityScanner.Schedule.Frequency, vulnerabilityScanner.Schedule.StartTime),
+		}
+
+		if vulnerabilityScanner.Username != nil {
+			vulnerabilityScannerResolved.Credentials = maskedCredentials
+			// the username is only compared masked, so it is not kept for other reports like ambiguous matches
+			vulnerabilityScannerResolved.Username = nil
+		}
+		sort.Strings(vulnerabilityScannerResolved.CIDRRanges)
+
+		vulnerabilityScannersResolved = append(vulnerabilityScannersResolved, vulnerabilityScannerResolved)
+	}
+
+	return vulnerabilityScannersResolved, nil
+}
+
+func GetScanProfilesResolved(qRadar *qradar.Client) ([]types.ScanProfileResolved, error) {
+	var scanProfiles []types.ScanProfile
+	err := getAPI(qRadar, "api/scanner/profiles", "", "", &scanProfiles)
+	if err != nil {
+		return nil, err
+	}
+
+	vulnerabilityScanners, err := getVulnerabilityScannersMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var scanProfilesResolved []types.ScanProfileResolved
+	for _, scanProfile := range scanProfiles {
+		scanProfileResolved := types.ScanProfileResolved{
+			ScanProfile:     scanProfile,
+			ScheduleSummary: scanScheduleSummary(scanProfile.Schedule.Frequency, scanProfile.Schedule.StartTime),
+		}
+
+		if scanProfile.ScannerID != nil {
+			scanProfileResolved.ScannerName = vulnerabilityScanners[*scanProfile.ScannerID]
+		}
+		if scanProfile.CredentialSetID != nil {
+			scanProfileResolved.Credentials = maskedCredentials
+		}
+		sort.Strings(scanProfileResolved.IPs)
+
+		scanProfilesResolved = append(scanProfilesResolved, scanProfileResolved)
+	}
+
+	return scanProfilesResolved, nil
+}
+
+func scanScheduleSummary(frequency *string, startTime *int64) string {
+	if frequency == nil {
+		return "Manual"
+	}
+	scheduleSummary := *frequency
+	if startTime != nil {
+		scheduleSummary += " at " + time.Unix(*startTime/1000, 0).UTC().Format("15:04") + " UTC"
+	}
+	return scheduleSummary
+}
+
+func GetCustomActionsResolved(qRadar *qradar.Client) ([]types.CustomActionResolved, error) {
+	var customActions []types.CustomAction
+	err := getAPI(qRadar, "api/analytics/custom_actions/actions", "", "", &customActions)
+	if err != nil {
+		return nil, err
+	}
+
+	interpreters, err := getCustomActionInterpretersMinimum(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	var customActionsResolved []types.CustomActionResolved
+	for _, customAction := range customActions {
+		customActionResolved := types.CustomActionResolved{
+			CustomAction: customAction,
+		}
+
+		if customAction.Interpreter != nil {
+			customActionResolved.InterpreterName = interpreters[*customAction.Interpreter]
+		}
+
+		if customAction.Script != nil {
+			script, err := getAPIRaw(qRadar, "api/analytics/custom_actions/scripts/"+strconv.Itoa(*customAction.Script))
+			if err != nil {
+				return nil, err
+			}
+			customActionResolved.ScriptChecksum = fmt.Sprintf("%x", sha256.Sum256(script))
+		}
+
+		for _, parameter := range customAction.Parameters {
+			parameterName := stringValue(parameter.Name) + " (" + stringValue(parameter.ParameterType) + "): "
+			if parameter.Encrypted != nil && *parameter.Encrypted {
+				parameterName += maskedCredentials
+			} else if parameter.Value != nil {
+				parameterName += *parameter.Value
+			}
+			customActionResolved.ParameterNames = append(customActionResolved.ParameterNames, parameterName)
+		}
+		sort.Strings(customActionResolved.ParameterNames)
+		// the raw parameters contain the encrypted values, only the masked ParameterNames are kept
+		customActionResolved.Parameters = nil
+
+		customActionsResolved = append(customActionsResolved, customActionResolved)
+	}
+
+	return customActionsResolved, nil
+}
+
+// GetRuleCustomActions returns the names of the custom actions each rule references in its responses.
+// References which can not be resolved on this installation are returned with an "unresolved" prefix.
+func GetRuleCustomActions(qRadar *qradar.Client, customActions []types.CustomActionResolved) (map[string][]string, error) {
+	rules, err := GetRulesResolved(qRadar)
+	if err != nil {
+		return nil, err
+	}
+
+	customActionNames := make(map[int]string)
+	for _, customAction := range customActions {
+		customActionNames[*customAction.ID] = *customAction.Name
+	}
+
+	ruleCustomActions := make(map[string][]string)
+	for _, rule := range rules {
+		for _, customAction := range rule.Responses.CustomAction {
+			name, ok := customActionNames[customAction.ID]
+			if !ok {
+				name = "unresolved custom action id " + strconv.Itoa(customAction.ID)
+			}
+			ruleCustomActions[rule.RuleXML.Name] = append(ruleCustomActions[rule.RuleXML.Name], name)
+		}
+	}
+
+	return ruleCustomActions, nil
+}
+
+var systemConfigurationEndpoints = []struct {
+	Section string
+	APIPath string
+}{
+	{"About", "api/system/about"},
+	{"Servers", "api/system/servers"},
+	{"Deployment Hosts", "api/config/deployment/hosts"},
+	{"Global System Notifications", "api/config/global_system_notifications"},
+	{"Event Retention Buckets", "api/config/event_retention_buckets"},
+	{"Flow Retention Buckets", "api/config/flow_retention_buckets"},
+	{"Offense Retention", "api/siem/offense_retention_settings"},
+	{"Offense Closing Reasons", "api/siem/offense_closing_reasons"},
+	{"Email Servers", "api/system/email_servers"},
+	{"Syslog Forwarding Destinations", "api/forwarding_destinations"},
+}
+
+// GetSystemConfiguration collects the system level settings exposed by the api as flat key/value map per section.
+// Sections whose endpoint does not exist in the api version of the installation are left out, all other errors are returned.
+func GetSystemConfiguration(qRadar *qradar.Client) (map[string]map[string]string, error) {
+	systemConfiguration := make(map[string]map[string]string)
+
+	for _, endpoint := range systemConfigurationEndpoints {
+		var rawConfiguration json.RawMessage
+		isAvailable, err := getAPIIfAvailable(qRadar, endpoint.APIPath, "", "", &rawConfiguration)
+		if err != nil {
+			return nil, fmt.Errorf("system configuration section %s: %v", endpoint.Section, err)
+		}
+		if !isAvailable {
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(rawConfiguration))
+		decoder.UseNumber()
+		var configuration interface{}
+		if err := decoder.Decode(&configuration); err != nil {
+			return nil, err
+		}
+		section := make(map[string]string)
+		flattenConfiguration("", configuration, section)
+		systemConfiguration[endpoint.Section] = section
+	}
+
+	return systemConfiguration, nil
+}
+
+// flattenConfiguration writes every leaf of value into result. List items are keyed by their name if they have one,
+// the other items are sorted by their content first, so the order returned by the api does not change the keys.
+func flattenConfiguration(prefix string, value interface{}, result map[string]string) {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typedValue {
+			childPrefix := key
+			if prefix != "" {
+				childPrefix = prefix + "." + key
+			}
+			flattenConfiguration(childPrefix, child, result)
+		}
+	case []interface{}:
+		var unnamedItems []string
+		encodedItems := make(map[string]interface{})
+		for _, child := range typedValue {
+			if childMap, ok := child.(map[string]interface{}); ok {
+				if name, ok := childMap["name"].(string); ok {
+					flattenConfiguration(prefix+"["+name+"]", child, result)
+					continue
+				}
+			}
+			// encoding/json writes map keys sorted, so only equal items share an encoding
+			encoded, _ := json.Marshal(child)
+			unnamedItems = append(unnamedItems, string(encoded))
+			encodedItems[string(encoded)] = child
+		}
+		sort.Strings(unnamedItems)
+		for index, encoded := range unnamedItems {
+			flattenConfiguration(prefix+"["+strconv.Itoa(index)+"]", encodedItems[encoded], result)
+		}
+	case nil:
+		result[prefix] = ""
+	default:
+		result[prefix] = fmt.Sprint(typedValue)
+	}
+}
+
+// stringValue returns the value of an optional api field or "" if it is not set.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func getTenantsMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.Tenant.Get(context.Background(), "", "deleted=false", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.TenantsToMap(resultItems)
+}
+func getLogSourceGroupsMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.LogSourceGroup.Get(context.Background(), "name,id", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.LogSourceGroupsToMap(resultItems)
+}
+func getLogSourceExtensionsMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.LogSourceExtension.Get(context.Background(), "name,id", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.LogSourceExtensionsToMap(resultItems)
+}
+func getDomainsMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.Domain.Get(context.Background(), "name,id", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.DomainsToMap(resultItems)
+}
+func getLogSourcesMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.LogSource.Get(context.Background(), "name,id", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.LogSourcesToMap(resultItems)
+}
+func getLogSourcesTypeMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.LogSourceType.Get(context.Background(), "id,name", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.LogSourceTypesToMap(resultItems)
+}
+func getLogLowLevelCategoryMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.LowLevelCategory.Get(context.Background(), "id,name", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.LowLevelCategoriesToMap(resultItems)
+}
+func getQIDsMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.QID.Get(context.Background(), "id,name", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.QIDsToMap(resultItems)
+}
+func getRulesMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.Rule.Get(context.Background(), "id,name", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.RulesToMap(resultItems)
+}
+func getBuildingBlocksMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.BuildingBlock.Get(context.Background(), "id,name", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.BuildingBlocksToMap(resultItems)
+}
+func getUserRolesMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	var resultItems []types.UserRole
+	err := getAPI(qRadar, "api/config/access/user_roles", "id,name", "", &resultItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.UserRolesToMap(resultItems)
+}
+func getSecurityProfilesMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	var resultItems []types.SecurityProfile
+	err := getAPI(qRadar, "api/config/access/security_profiles", "id,name", "", &resultItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.SecurityProfilesToMap(resultItems)
+}
+func getRegexPropertiesMinimum(qRadar *qradar.Client) (map[string]string, error) {
+	resultItems, err := qRadar.RegexProperty.Get(context.Background(), "identifier,name", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.RegexPropertiesToMap(resultItems)
+}
+func getRegexPropertyIDsMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	resultItems, err := qRadar.RegexProperty.Get(context.Background(), "id,name", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.RegexPropertyIDsToMap(resultItems)
+}
+func getForwardingDestinationsMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	var resultItems []types.ForwardingDestination
+	err := getAPI(qRadar, "api/config/event_forwarding/forwarding_destinations", "id,name", "", &resultItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.ForwardingDestinationsToMap(resultItems)
+}
+func getDataObfuscationProfilesMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	var resultItems []types.DataObfuscationProfile
+	err := getAPI(qRadar, "api/config/data_obfuscation/profiles", "id,name", "", &resultItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.DataObfuscationProfilesToMap(resultItems)
+}
+func getSavedSearchesMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	var resultItems []types.SavedSearch
+	err := getAPI(qRadar, "api/ariel/saved_searches", "id,name", "", &resultItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.SavedSearchesToMap(resultItems)
+}
+func getReportGroupsMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	var resultItems []types.ReportGroup
+	err := getAPI(qRadar, "api/config/reports/report_groups", "id,name", "", &resultItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.ReportGroupsToMap(resultItems)
+}
+func getVulnerabilityScannersMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	var resultItems []types.VulnerabilityScanner
+	err := getAPI(qRadar, "api/config/vulnerability_scanners", "id,name", "", &resultItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.VulnerabilityScannersToMap(resultItems)
+}
+func getCustomActionInterpretersMinimum(qRadar *qradar.Client) (map[int]string, error) {
+	var resultItems []types.CustomActionInterpreter
+	err := getAPI(qRadar, "api/analytics/custom_actions/interpreters", "id,name", "", &resultItems)
+	if err != nil {
+		return nil, err
+	}
+
+	return converters.CustomActionInterpretersToMap(resultItems)
+}
+func getQIDsByID(qRadar *qradar.Client) (map[int]qradar.QID, error) {
+	resultItems, err := qRadar.QID.Get(context.Background(), "id,name,severity,low_level_category_id", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	resultMap := make(map[int]qradar.QID)
+	for _, item := range resultItems {
+		resultMap[*item.ID] = item
+	}
+	return resultMap, nil
 }
\ No newline at end of file
@@ -5,6 +5,7 @@ import (
 	"os"
 	"qradar-content-compare/types"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -13,9 +14,16 @@ func ReportToTerminal(reports []types.Report) {
 	for _, report := range reports {
 		fmt.Println(separator)
 		fmt.Println("Report for: ", report.ElementType)
+		for i, summaryLine := range report.Summary {
+			fmt.Println(summaryLine + summarySeveritySuffix(report, i))
+		}
 		fmt.Println("Elements Ok: " + strconv.Itoa(report.SameCount))
+		fmt.Println("Severity: " + severitySummary(report.HighestSeverity, report.SeverityCounts))
+		if report.SuppressedCount > 0 {
+			fmt.Println("Differences suppressed by ignore file: " + strconv.Itoa(report.SuppressedCount))
+		}
 		if len(report.MissingRecords) > 0 {
-			fmt.Println("Elements missing in new QRadar: ")
+			fmt.Println("Elements missing in new QRadar" + severitySuffix(report.MissingSeverity) + ": ")
 			for _, missingElement := range report.MissingRecords {
 				fmt.Println(missingElement)
 			}
@@ -29,16 +37,125 @@ func ReportToTerminal(reports []types.Report) {
 			for _, differentRecord := range report.DifferentRecords {
 				fmt.Println(differentRecord.RecordName)
 				for _, differentElement := range differentRecord.DifferentElements {
-					fmt.Println("Element: " + differentElement.Name)
+					fmt.Println("Element: " + differentElement.Name + severitySuffix(differentElement.Severity))
 					fmt.Println("Old Value: ", differentElement.OldValue)
 					fmt.Println("New Value: ", differentElement.NewValue)
+					if differentElement.Normalised {
+						fmt.Println("Old Value (normalised): ", differentElement.NormalisedOldValue)
+						fmt.Println("New Value (normalised): ", differentElement.NormalisedNewValue)
+					}
 				}
 				fmt.Println(separator)
 			}
 		} else {
 			fmt.Println("Elements different in new QRadar: 0")
 		}
+
+		if len(report.RenamedRecords) > 0 {
+			fmt.Println("Elements probably renamed in new QRadar: ")
+			for _, renamedRecord := range report.RenamedRecords {
+				fmt.Println(renamedRecordName(renamedRecord) + severitySuffix(renamedRecord.Severity))
+			}
+			fmt.Println(separator)
+		}
+
+		if len(report.AmbiguousMatches) > 0 {
+			fmt.Println("Ambiguous matches: ")
+			for _, ambiguousMatch := range report.AmbiguousMatches {
+				fmt.Println(ambiguousMatchName(ambiguousMatch) + severitySuffix(ambiguousMatch.Severity))
+				for _, differentField := range ambiguousMatch.DifferentFields {
+					fmt.Println("Element: " + differentField.Name)
+					for i, value := range differentField.Values {
+						fmt.Println("Value "+strconv.Itoa(i+1)+": ", value)
+					}
+				}
+				fmt.Println(separator)
+			}
+		}
+	}
+}
+
+func reportFolderName() string {
+	return "qradar_compare_report_" + time.Now().Format("02_01_2006") + "/"
+}
+
+// SeveritySummaryToTerminal prints the highest severity of each report and the overall severity counts.
+func SeveritySummaryToTerminal(reports []types.Report) {
+	for _, line := range severitySummaryLines(reports) {
+		fmt.Println(line)
+	}
+}
+
+// SeveritySummaryToFile writes the highest severity of each report and the overall severity counts to the report folder.
+func SeveritySummaryToFile(reports []types.Report) error {
+	folderName := reportFolderName()
+	if err := os.MkdirAll(folderName, 0700); err != nil {
+		return err
+	}
+
+	file, err := os.Create(folderName + "Severity Summary.txt")
+	if err != nil {
+		return err
+	}
+	for _, line := range severitySummaryLines(reports) {
+		fmt.Fprintln(file, line)
+	}
+	return file.Close()
+}
+
+func severitySummaryLines(reports []types.Report) []string {
+	var lines []string
+	overallCounts := make(map[string]int)
+	overallHighestSeverity := ""
+	for _, report := range reports {
+		lines = append(lines, report.ElementType+": "+severitySummary(report.HighestSeverity, report.SeverityCounts))
+		for severity, count := range report.SeverityCounts {
+			overallCounts[severity] += count
+		}
+		if types.SeverityRank(report.HighestSeverity) > types.SeverityRank(overallHighestSeverity) {
+			overallHighestSeverity = report.HighestSeverity
+		}
 	}
+	lines = append(lines, "Overall: "+severitySummary(overallHighestSeverity, overallCounts))
+	return lines
+}
+
+func severitySummary(highestSeverity string, severityCounts map[string]int) string {
+	if highestSeverity == "" {
+		return "no differences"
+	}
+	var counts []string
+	for _, severity := range types.Severities {
+		counts = append(counts, severity+": "+strconv.Itoa(severityCounts[severity]))
+	}
+	return "highest " + highestSeverity + " (" + strings.Join(counts, ", ") + ")"
+}
+
+func severitySuffix(severity string) string {
+	if severity == "" {
+		return ""
+	}
+	return " [" + severity + "]"
+}
+
+// summarySeveritySuffix returns the severity suffix of the summary line with the given index.
+func summarySeveritySuffix(report types.Report, index int) string {
+	if index >= len(report.SummarySeverities) {
+		return ""
+	}
+	return severitySuffix(report.SummarySeverities[index])
+}
+
+func renamedRecordName(renamedRecord types.RenamedRecord) string {
+	return fmt.Sprintf("%s -> %s (Confidence: %.0f%%)", renamedRecord.OldName, renamedRecord.NewName, renamedRecord.Confidence*100)
+}
+
+func ambiguousMatchName(ambiguousMatch types.AmbiguousMatch) string {
+	name := fmt.Sprintf("%s (%d records in %s QRadar)", ambiguousMatch.Key, ambiguousMatch.RecordCount, ambiguousMatch.QRadar)
+	if len(ambiguousMatch.DifferentFields) == 0 {
+		name += ", records are identical"
+	}
+	return name
 }
 
 func ReportToFiles(reports []types.Report) error {
@@ -55,7 +172,7 @@ func ReportToFiles(reports []types.Report) error {
 
 func ReportToFile(report types.Report) error {
 	separator := "***************************"
-	folderName := "qradar_compare_report_"+ time.Now().Format("02_01_2006")+ "/"
+	folderName := reportFolderName()
 	fmt.Println("write report for " + report.ElementType + " to folder "+ folderName)
 
 	fileName := folderName + report.ElementType + ".txt"
@@ -72,10 +189,21 @@ func ReportToFile(report types.Report) error {
 	fmt.Fprintln(file,"Records OK: " + strconv.Itoa(report.SameCount))
 	fmt.Fprintln(file,"Records in old QRadar: " + strconv.Itoa(report.OldCount))
 	fmt.Fprintln(file,"Records in new QRadar: " + strconv.Itoa(report.NewCount))
+	fmt.Fprintln(file, "Differences suppressed by ignore file: "+strconv.Itoa(report.SuppressedCount))
+	fmt.Fprintln(file, "Severity: "+severitySummary(report.HighestSeverity, report.SeverityCounts))
+
+	if len(report.Summary) > 0 {
+		fmt.Fprintln(file, "")
+		fmt.Fprintln(file, "Summary: ")
+		fmt.Fprintln(file, separator)
+		for i, summaryLine := range report.Summary {
+			fmt.Fprintln(file, summaryLine+summarySeveritySuffix(report, i))
+		}
+	}
 
 	if len(report.MissingRecords) > 0 {
 		fmt.Fprintln(file, "")
-		fmt.Fprintln(file,"Records missing in new QRadar: ")
+		fmt.Fprintln(file,"Records missing in new QRadar" + severitySuffix(report.MissingSeverity) + ": ")
 		fmt.Fprintln(file, separator)
 		for _, missingElement := range report.MissingRecords {
 			fmt.Fprintln(file, missingElement)
@@ -94,9 +222,13 @@ func ReportToFile(report types.Report) error {
 		for _, differentRecord := range report.DifferentRecords {
 			fmt.Fprintln(file,differentRecord.RecordName)
 			for _, differentElement := range differentRecord.DifferentElements {
-				fmt.Fprintln(file,"Element: " + differentElement.Name)
+				fmt.Fprintln(file,"Element: " + differentElement.Name + severitySuffix(differentElement.Severity))
 				fmt.Fprintln(file,"Old Value: ", differentElement.OldValue)
 				fmt.Fprintln(file,"New Value: ", differentElement.NewValue)
+				if differentElement.Normalised {
+					fmt.Fprintln(file, "Old Value (normalised): ", differentElement.NormalisedOldValue)
+					fmt.Fprintln(file, "New Value (normalised): ", differentElement.NormalisedNewValue)
+				}
 			}
 			fmt.Fprintln(file, "")
 		}
@@ -105,6 +237,31 @@ func ReportToFile(report types.Report) error {
 		fmt.Fprintln(file,"Records different in new QRadar: 0")
 		fmt.Fprintln(file, separator)
 	}
+
+	if len(report.RenamedRecords) > 0 {
+		fmt.Fprintln(file, "")
+		fmt.Fprintln(file, "Records probably renamed in new QRadar: ")
+		fmt.Fprintln(file, separator)
+		for _, renamedRecord := range report.RenamedRecords {
+			fmt.Fprintln(file, renamedRecordName(renamedRecord)+severitySuffix(renamedRecord.Severity))
+		}
+	}
+
+	if len(report.AmbiguousMatches) > 0 {
+		fmt.Fprintln(file, "")
+		fmt.Fprintln(file, "Ambiguous matches (records sharing the same key): ")
+		fmt.Fprintln(file, separator)
+		for _, ambiguousMatch := range report.AmbiguousMatches {
+			fmt.Fprintln(file, ambiguousMatchName(ambiguousMatch)+severitySuffix(ambiguousMatch.Severity))
+			for _, differentField := range ambiguousMatch.DifferentFields {
+				fmt.Fprintln(file, "Element: "+differentField.Name)
+				for i, value := range differentField.Values {
+					fmt.Fprintln(file, "Value "+strconv.Itoa(i+1)+": ", value)
+				}
+			}
+			fmt.Fprintln(file, "")
+		}
+	}
 	if err := file.Close(); err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/xml"
+	"fmt"
 	"github.com/ilyaglow/go-qradar"
 )
 
@@ -62,8 +63,34 @@ type RulesWithDataResolved struct {
 
 type DsmResolved struct {
 	qradar.DSM
-	LogSourceTypeName string
-	QidName           string
+	LogSourceTypeName    string
+	QidName              string
+	QidSeverity          int
+	LowLevelCategoryName string
+}
+
+// DsmMappingKey identifies a DSM mapping independent of the installation.
+type DsmMappingKey struct {
+	LogSourceTypeName      string
+	LogSourceEventID       string
+	LogSourceEventCategory string
+}
+
+func (key DsmMappingKey) String() string {
+	return fmt.Sprintf("Log Source Type: %s, Event ID: %s, Event Category: %s", key.LogSourceTypeName, key.LogSourceEventID, key.LogSourceEventCategory)
+}
+
+func (dsm DsmResolved) Key() DsmMappingKey {
+	key := DsmMappingKey{
+		LogSourceTypeName: dsm.LogSourceTypeName,
+	}
+	if dsm.LogSourceEventID != nil {
+		key.LogSourceEventID = *dsm.LogSourceEventID
+	}
+	if dsm.LogSourceEventCategory != nil {
+		key.LogSourceEventCategory = *dsm.LogSourceEventCategory
+	}
+	return key
 }
 
 type QIDsResolved struct {
@@ -72,6 +99,25 @@ type QIDsResolved struct {
 	LogSourceTypeName    string
 }
 
+// QIDKey identifies a QID independent of its QID number, which differs for custom QIDs between installations.
+type QIDKey struct {
+	Name                 string
+	LogSourceTypeName    string
+	LowLevelCategoryName string
+}
+
+func (key QIDKey) String() string {
+	return fmt.Sprintf("QID Name: %s, Log Source Type: %s, Low Level Category: %s", key.Name, key.LogSourceTypeName, key.LowLevelCategoryName)
+}
+
+func (qid QIDsResolved) Key() QIDKey {
+	return QIDKey{
+		Name:                 *qid.Name,
+		LogSourceTypeName:    qid.LogSourceTypeName,
+		LowLevelCategoryName: qid.LowLevelCategoryName,
+	}
+}
+
 type DifferentQIDs struct {
 	OldQIDResolved QIDsResolved
 	NewQIDResolved QIDsResolved
@@ -129,6 +175,9 @@ type RuleXML struct {
 		ReferenceMapOfMaps       bool   `xml:"referenceMapOfMaps,attr"`
 		ReferenceMapOfSets       bool   `xml:"referenceMapOfSets,attr"`
 		ReferenceMap             bool   `xml:"referenceMap,attr"`
+		CustomAction             []struct {
+			ID int `xml:"id,attr"`
+		} `xml:"customAction"`
 		Newevent                 struct {
 			Text                  string `xml:",chardata"`
 			LowLevelCategory      string `xml:"lowLevelCategory,attr"`
@@ -199,13 +248,422 @@ type DifferentPropertyExpressionRegex struct {
 	NewPropertyExpressionRegexResolved PropertyExpressionRegexResolved
 }
 
+type PropertyExpressionResolved struct {
+	qradar.PropertyExpression
+	ExpressionType       string
+	PropertyName         string
+	LogSourceTypeName    string
+	QidName              string
+	LogSourceName        string
+	LowLevelCategoryName string
+}
+
+type CalculatedProperty struct {
+	ID               *int                      `json:"id,omitempty"`
+	Identifier       *string                   `json:"identifier,omitempty"`
+	Name             *string                   `json:"name,omitempty"`
+	Description      *string                   `json:"description,omitempty"`
+	PropertyType     *string                   `json:"property_type,omitempty"`
+	OperandOne       CalculatedPropertyOperand `json:"operand_one,omitempty"`
+	Operator         *string                   `json:"operator,omitempty"`
+	OperandTwo       CalculatedPropertyOperand `json:"operand_two,omitempty"`
+	UseForRuleEngine *bool                     `json:"use_for_rule_engine,omitempty"`
+	Username         *string                   `json:"username,omitempty"`
+}
+
+// CalculatedPropertyOperand is either a reference to another property (PROPERTY) or a NUMERIC_CONSTANT.
+type CalculatedPropertyOperand struct {
+	Type  *string  `json:"type,omitempty"`
+	ID    *int     `json:"id,omitempty"`
+	Value *float64 `json:"value,omitempty"`
+}
+
+type CalculatedPropertyResolved struct {
+	CalculatedProperty
+	OperandOneName string
+	OperandTwoName string
+	Expression     string
+}
+
+type AQLProperty struct {
+	ID               *int    `json:"id,omitempty"`
+	Identifier       *string `json:"identifier,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	PropertyType     *string `json:"property_type,omitempty"`
+	Expression       *string `json:"expression,omitempty"`
+	UseForRuleEngine *bool   `json:"use_for_rule_engine,omitempty"`
+	Username         *string `json:"username,omitempty"`
+}
+
+type RegexPropertyResolved struct {
+	qradar.RegexProperty
+	Expressions []PropertyExpressionRegexResolved
+}
+
+type ForwardingDestination struct {
+	ID          *int    `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Host        *string `json:"host,omitempty"`
+	Port        *int    `json:"port,omitempty"`
+	Protocol    *string `json:"protocol,omitempty"`
+	EventFormat *string `json:"event_format,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+type RoutingRule struct {
+	ID             *int                `json:"id,omitempty"`
+	Name           *string             `json:"name,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Mode           *string             `json:"mode,omitempty"`
+	Enabled        *bool               `json:"enabled,omitempty"`
+	RoutingOptions []string            `json:"routing_options,omitempty"`
+	DestinationIDs []int               `json:"destination_ids,omitempty"`
+	Filters        []RoutingRuleFilter `json:"filters,omitempty"`
+}
+
+type RoutingRuleFilter struct {
+	Type     *string `json:"type,omitempty"`
+	Operator *string `json:"operator,omitempty"`
+	Value    *string `json:"value,omitempty"`
+}
+
+type RoutingRuleResolved struct {
+	RoutingRule
+	FilterNames      []string
+	DestinationNames []string
+}
+
+type RetentionBucket struct {
+	ID             *int    `json:"id,omitempty"`
+	BucketID       *int    `json:"bucket_id,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Database       *string `json:"database,omitempty"`
+	Enabled        *bool   `json:"enabled,omitempty"`
+	Filter         *string `json:"filter,omitempty"`
+	Period         *int    `json:"period,omitempty"`
+	Priority       *int    `json:"priority,omitempty"`
+	Compression    *string `json:"compression,omitempty"`
+	DeletionPolicy *string `json:"deletion_policy,omitempty"`
+	TenantID       *int    `json:"tenant_id,omitempty"`
+	DomainID       *int    `json:"domain_id,omitempty"`
+}
+
+type RetentionBucketResolved struct {
+	RetentionBucket
+	TenantName string
+	DomainName string
+}
+
+type DataObfuscationProfile struct {
+	ID          *int    `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+	DomainIDs   []int   `json:"domain_ids,omitempty"`
+}
+
+type DataObfuscationProfileResolved struct {
+	DataObfuscationProfile
+	DomainNames []string
+}
+
+type DataObfuscationExpression struct {
+	ID        *int    `json:"id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	ProfileID *int    `json:"profile_id,omitempty"`
+	Regex     *string `json:"regex,omitempty"`
+	Property  *string `json:"property,omitempty"`
+	Enabled   *bool   `json:"enabled,omitempty"`
+}
+
+type DataObfuscationExpressionResolved struct {
+	DataObfuscationExpression
+	ProfileName string
+}
+
+type SavedSearch struct {
+	ID          *int    `json:"id,omitempty"`
+	UID         *string `json:"uid,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Database    *string `json:"database,omitempty"`
+	AQL         *string `json:"aql,omitempty"`
+	Owner       *string `json:"owner,omitempty"`
+	IsShared    *bool   `json:"is_shared,omitempty"`
+}
+
+type HistoricalCorrelationProfile struct {
+	ID            *int    `json:"id,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+	SavedSearchID *int    `json:"saved_search_id,omitempty"`
+	RuleIDs       []int   `json:"rule_ids,omitempty"`
+	Schedule      struct {
+		Recurrence *string `json:"recurrence,omitempty"`
+		Interval   *int    `json:"interval,omitempty"`
+		StartTime  *int64  `json:"start_time,omitempty"`
+	} `json:"schedule,omitempty"`
+}
+
+type HistoricalCorrelationProfileResolved struct {
+	HistoricalCorrelationProfile
+	SavedSearchName string
+	RuleNames       []string
+	ScheduleSummary string
+}
+
+type ReportTemplate struct {
+	ID             *int     `json:"id,omitempty"`
+	Title          *string  `json:"title,omitempty"`
+	Description    *string  `json:"description,omitempty"`
+	Owner          *string  `json:"owner,omitempty"`
+	GroupIDs       []int    `json:"group_ids,omitempty"`
+	Formats        []string `json:"formats,omitempty"`
+	SavedSearchIDs []int    `json:"saved_search_ids,omitempty"`
+	Schedule       struct {
+		Frequency *string `json:"frequency,omitempty"`
+		Day       *string `json:"day,omitempty"`
+		Hour      *int    `json:"hour,omitempty"`
+	} `json:"schedule,omitempty"`
+}
+
+type ReportGroup struct {
+	ID   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type ReportTemplateResolved struct {
+	ReportTemplate
+	GroupNames       []string
+	SavedSearchNames []string
+	ScheduleSummary  string
+}
+
+type Dashboard struct {
+	ID     *int            `json:"id,omitempty"`
+	Name   *string         `json:"name,omitempty"`
+	Owner  *string         `json:"owner,omitempty"`
+	Shared *bool           `json:"shared,omitempty"`
+	Items  []DashboardItem `json:"items,omitempty"`
+}
+
+type DashboardItem struct {
+	ID            *int    `json:"id,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	ChartType     *string `json:"chart_type,omitempty"`
+	SavedSearchID *int    `json:"saved_search_id,omitempty"`
+	TimeRange     *string `json:"time_range,omitempty"`
+}
+
+type DashboardItemResolved struct {
+	DashboardItem
+	SavedSearchName string
+}
+
+type DashboardResolved struct {
+	Dashboard
+	ItemsResolved []DashboardItemResolved
+}
+
+type AssetProperty struct {
+	ID       *int    `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	DataType *string `json:"data_type,omitempty"`
+	Custom   *bool   `json:"custom,omitempty"`
+}
+
+type Asset struct {
+	ID         *int `json:"id,omitempty"`
+	DomainID   *int `json:"domain_id,omitempty"`
+	Interfaces []struct {
+		MacAddress  *string `json:"mac_address,omitempty"`
+		IPAddresses []struct {
+			Value *string `json:"value,omitempty"`
+		} `json:"ip_addresses,omitempty"`
+	} `json:"interfaces,omitempty"`
+	Hostnames []struct {
+		Name *string `json:"name,omitempty"`
+	} `json:"hostnames,omitempty"`
+	Properties []struct {
+		Name  *string `json:"name,omitempty"`
+		Value *string `json:"value,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+type AssetResolved struct {
+	Asset
+	DomainName  string
+	IPAddresses []string
+	Hostnames   []string
+	Properties  []string
+}
+
+type VulnerabilityScanner struct {
+	ID          *int     `json:"id,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	ScannerType *string  `json:"scanner_type,omitempty"`
+	Host        *string  `json:"host,omitempty"`
+	CIDRRanges  []string `json:"cidr_ranges,omitempty"`
+	Username    *string  `json:"username,omitempty"`
+	Schedule    struct {
+		Frequency *string `json:"frequency,omitempty"`
+		StartTime *int64  `json:"start_time,omitempty"`
+	} `json:"schedule,omitempty"`
+}
+
+type ScanProfile struct {
+	ID              *int     `json:"id,omitempty"`
+	Name            *string  `json:"name,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	ScanType        *string  `json:"scan_type,omitempty"`
+	ScannerID       *int     `json:"scanner_id,omitempty"`
+	IPs             []string `json:"ips,omitempty"`
+	CredentialSetID *int     `json:"credential_set_id,omitempty"`
+	Schedule        struct {
+		Frequency *string `json:"frequency,omitempty"`
+		StartTime *int64  `json:"start_time,omitempty"`
+	} `json:"schedule,omitempty"`
+}
+
+// VulnerabilityScannerResolved holds the masked username as credentials, the password is never requested.
+type VulnerabilityScannerResolved struct {
+	VulnerabilityScanner
+	Credentials     string
+	ScheduleSummary string
+}
+
+type ScanProfileResolved struct {
+	ScanProfile
+	ScannerName     string
+	Credentials     string
+	ScheduleSummary string
+}
+
+type CustomAction struct {
+	ID          *int    `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Interpreter *int    `json:"interpreter,omitempty"`
+	Script      *int    `json:"script,omitempty"`
+	Parameters  []struct {
+		Name          *string `json:"name,omitempty"`
+		ParameterType *string `json:"parameter_type,omitempty"`
+		Encrypted     *bool   `json:"encrypted,omitempty"`
+		Value         *string `json:"value,omitempty"`
+	} `json:"parameters,omitempty"`
+}
+
+type CustomActionInterpreter struct {
+	ID   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// CustomActionResolved holds the parameters only as ParameterNames with masked encrypted values,
+// the raw Parameters of the CustomAction are cleared.
+type CustomActionResolved struct {
+	CustomAction
+	InterpreterName string
+	ScriptChecksum  string
+	ParameterNames  []string
+}
+
+// AuthorizedService represents QRadar's authorized service. The token is never requested.
+type AuthorizedService struct {
+	ID                *int    `json:"id,omitempty"`
+	Label             *string `json:"label,omitempty"`
+	RoleID            *int    `json:"role_id,omitempty"`
+	SecurityProfileID *int    `json:"security_profile_id,omitempty"`
+	TenantID          *int    `json:"tenant_id,omitempty"`
+	ExpiryDate        *int64  `json:"expiry_date,omitempty"`
+	CreatedBy         *string `json:"created_by,omitempty"`
+	CreationDate      *int64  `json:"creation_date,omitempty"`
+}
+
+type UserRole struct {
+	ID   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type SecurityProfile struct {
+	ID   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type AuthorizedServiceResolved struct {
+	AuthorizedService
+	RoleName            string
+	SecurityProfileName string
+	TenantName          string
+	Expiry              string
+}
+
+type Extension struct {
+	ID          *int    `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Version     *string `json:"version,omitempty"`
+	Status      *string `json:"status,omitempty"`
+	Author      *string `json:"author,omitempty"`
+	InstalledBy *string `json:"installed_by,omitempty"`
+}
+
+type Application struct {
+	Manifest struct {
+		Name        *string `json:"name,omitempty"`
+		Description *string `json:"description,omitempty"`
+		Version     *string `json:"version,omitempty"`
+		UUID        *string `json:"uuid,omitempty"`
+	} `json:"manifest,omitempty"`
+	ApplicationState struct {
+		ApplicationID *int    `json:"application_id,omitempty"`
+		Status        *string `json:"status,omitempty"`
+	} `json:"application_state,omitempty"`
+}
+
 type Report struct {
 	ElementType      string
+	Summary          []string
 	SameCount        int
+	SuppressedCount  int
 	OldCount		 int
 	NewCount		 int
 	MissingRecords   []string
 	DifferentRecords []DifferentRecord
+	AmbiguousMatches []AmbiguousMatch
+	RenamedRecords   []RenamedRecord
+	// MissingSeverity is the severity of the missing records, SummarySeverities holds the severity of every
+	// summary line. SeverityCounts counts the missing records, summary lines, ambiguous matches, renamed records
+	// and the differences per severity.
+	MissingSeverity   string
+	SummarySeverities []string
+	SeverityCounts    map[string]int
+	HighestSeverity   string
+}
+
+// Severities of differences from the most to the least important.
+const (
+	SeverityCritical = "critical"
+	SeverityMajor    = "major"
+	SeverityMinor    = "minor"
+	SeverityInfo     = "info"
+)
+
+// Severities lists the severities from the most to the least important.
+var Severities = []string{SeverityCritical, SeverityMajor, SeverityMinor, SeverityInfo}
+
+// SeverityRank returns a higher rank for a more important severity and 0 for an unknown or empty severity.
+func SeverityRank(severity string) int {
+	for i, knownSeverity := range Severities {
+		if severity == knownSeverity {
+			return len(Severities) - i
+		}
+	}
+	return 0
 }
 
 type DifferentRecord struct {
@@ -217,4 +675,32 @@ type DifferentElement struct {
 	Name     string
 	OldValue string
 	NewValue string
+	// Normalised is set if the normalised values differ from the raw values.
+	Normalised         bool
+	NormalisedOldValue string
+	NormalisedNewValue string
+	Severity           string
+}
+
+// AmbiguousMatch lists the records of one QRadar which share the same match key.
+type AmbiguousMatch struct {
+	QRadar          string
+	Key             string
+	RecordCount     int
+	DifferentFields []AmbiguousField
+	Severity        string
+}
+
+// AmbiguousField holds the values of a field which differs between the records of an AmbiguousMatch.
+type AmbiguousField struct {
+	Name   string
+	Values []string
+}
+
+// RenamedRecord is a record which was paired with a record of the new QRadar by similarity instead of its key.
+type RenamedRecord struct {
+	OldName    string
+	NewName    string
+	Confidence float64
+	Severity   string
 }